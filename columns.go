@@ -0,0 +1,248 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Columns stores a monetary value as two database columns, a numeric amount and the unique ID of the currency.
+// In contrast to Value, this allows to aggregate, index and compare amounts in SQL.
+//
+// It is meant to be embedded into GORM models.
+// The precision and scale of the amount column can be set via the tags of the embedding field:
+//
+//	type Account struct {
+//		ID      uint
+//		Balance money.Columns `gorm:"embedded;embeddedPrefix:balance_;precision:20;scale:4"`
+//	}
+//
+// This results in the columns `balance_amount NUMERIC(20,4)` and `balance_currency INTEGER`.
+// Values without currency are stored with a NULL currency.
+type Columns struct {
+	Amount   AmountColumn
+	Currency CurrencyIDColumn
+}
+
+// NewColumns returns v in the form of two database columns.
+func NewColumns(v Value) Columns {
+	return Columns{
+		Amount:   AmountColumn{v.amount},
+		Currency: CurrencyIDColumn{v.currency},
+	}
+}
+
+// ToValue returns the monetary value that is stored in the columns.
+func (c Columns) ToValue() Value {
+	return Value{amount: c.Amount.amount, currency: c.Currency.currency}
+}
+
+// CodeColumns stores a monetary value as two database columns, a numeric amount and the unique code of the currency.
+// This is the same as Columns, but the currency is human readable, e.g. `ISO4217-EUR`.
+//
+//	type Account struct {
+//		ID      uint
+//		Balance money.CodeColumns `gorm:"embedded;embeddedPrefix:balance_;precision:20;scale:4"`
+//	}
+//
+// This results in the columns `balance_amount NUMERIC(20,4)` and `balance_currency VARCHAR`.
+// Values without currency are stored with a NULL currency.
+type CodeColumns struct {
+	Amount   AmountColumn
+	Currency CurrencyCodeColumn
+}
+
+// NewCodeColumns returns v in the form of two database columns.
+func NewCodeColumns(v Value) CodeColumns {
+	return CodeColumns{
+		Amount:   AmountColumn{v.amount},
+		Currency: CurrencyCodeColumn{v.currency},
+	}
+}
+
+// ToValue returns the monetary value that is stored in the columns.
+func (c CodeColumns) ToValue() Value {
+	return Value{amount: c.Amount.amount, currency: c.Currency.currency}
+}
+
+// AmountColumn is the amount of a monetary value stored as numeric database column.
+type AmountColumn struct {
+	amount decimal.Decimal
+}
+
+// Value implements the valuer interface of databases.
+func (a AmountColumn) Value() (driver.Value, error) {
+	return a.amount.Value()
+}
+
+// Scan fills the object with data matching the given value from the database.
+func (a *AmountColumn) Scan(value interface{}) error {
+	if value == nil {
+		return fmt.Errorf("can't scan NULL into %T", a)
+	}
+
+	return a.amount.Scan(value)
+}
+
+// GormDataType returns the general GORM datatype of the field.
+func (a AmountColumn) GormDataType() string {
+	return "numeric"
+}
+
+// GormDBDataType returns the datatype that a database should use for the field.
+// The precision and scale are taken from the `precision` and `scale` tag settings.
+func (a AmountColumn) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	var precision, scale int
+	if str, ok := field.TagSettings["PRECISION"]; ok {
+		precision, _ = strconv.Atoi(str)
+	}
+	if str, ok := field.TagSettings["SCALE"]; ok {
+		scale, _ = strconv.Atoi(str)
+	}
+
+	typ := "NUMERIC"
+	if db.Dialector.Name() == "mysql" {
+		typ = "DECIMAL"
+		if precision <= 0 {
+			// MySQL would default to DECIMAL(10,0).
+			precision, scale = 65, 30
+		}
+	}
+
+	switch {
+	case precision > 0 && scale > 0:
+		return fmt.Sprintf("%s(%d,%d)", typ, precision, scale)
+	case precision > 0:
+		return fmt.Sprintf("%s(%d)", typ, precision)
+	}
+	return typ
+}
+
+// CurrencyIDColumn is the currency of a monetary value stored as its unique ID in an integer database column.
+type CurrencyIDColumn struct {
+	currency Currency
+}
+
+// Value implements the valuer interface of databases.
+// No currency results in NULL.
+func (c CurrencyIDColumn) Value() (driver.Value, error) {
+	if c.currency == nil {
+		return nil, nil
+	}
+
+	return int64(c.currency.UniqueID()), nil
+}
+
+// Scan fills the object with data matching the given value from the database.
+// NULL and 0 result in no currency.
+func (c *CurrencyIDColumn) Scan(value interface{}) error {
+	var uniqueID int64
+	switch value := value.(type) {
+	case nil:
+		c.currency = nil
+		return nil
+	case int64:
+		uniqueID = value
+	case string, []byte:
+		var err error
+		if uniqueID, err = strconv.ParseInt(fmt.Sprintf("%s", value), 10, 32); err != nil {
+			return fmt.Errorf("failed to parse currency ID: %w", err)
+		}
+	default:
+		return fmt.Errorf("incompatible type %T, expected integer", value)
+	}
+
+	if uniqueID == 0 {
+		c.currency = nil
+		return nil
+	}
+	if uniqueID < -1<<31 || uniqueID >= 1<<31 {
+		return fmt.Errorf("currency ID %d is outside the allowed range", uniqueID)
+	}
+
+	cur := Currencies.ByUniqueID(int32(uniqueID))
+	if cur == nil {
+		return &ErrorCantFindUniqueID{int32(uniqueID)}
+	}
+
+	c.currency = cur
+	return nil
+}
+
+// GormDataType returns the general GORM datatype of the field.
+func (c CurrencyIDColumn) GormDataType() string {
+	return string(schema.Int)
+}
+
+// GormDBDataType returns the datatype that a database should use for the field.
+func (c CurrencyIDColumn) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "INTEGER"
+}
+
+// CurrencyCodeColumn is the currency of a monetary value stored as its unique code in a text database column.
+type CurrencyCodeColumn struct {
+	currency Currency
+}
+
+// Value implements the valuer interface of databases.
+// No currency results in NULL.
+func (c CurrencyCodeColumn) Value() (driver.Value, error) {
+	if c.currency == nil {
+		return nil, nil
+	}
+
+	return c.currency.UniqueCode(), nil
+}
+
+// Scan fills the object with data matching the given value from the database.
+// NULL and an empty string result in no currency.
+func (c *CurrencyCodeColumn) Scan(value interface{}) error {
+	var uniqueCode string
+	switch value := value.(type) {
+	case nil:
+	case string:
+		uniqueCode = value
+	case []byte:
+		uniqueCode = string(value)
+	default:
+		return fmt.Errorf("incompatible type %T, expected string", value)
+	}
+
+	if uniqueCode == "" {
+		c.currency = nil
+		return nil
+	}
+
+	cur := Currencies.ByUniqueCode(uniqueCode)
+	if cur == nil {
+		return &ErrorCantFindUniqueCode{uniqueCode}
+	}
+
+	c.currency = cur
+	return nil
+}
+
+// GormDataType returns the general GORM datatype of the field.
+func (c CurrencyCodeColumn) GormDataType() string {
+	return string(schema.String)
+}
+
+// GormDBDataType returns the datatype that a database should use for the field.
+func (c CurrencyCodeColumn) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "mysql", "sqlite":
+		return "VARCHAR(255)"
+	case "postgres":
+		return "VARCHAR"
+	}
+	return ""
+}
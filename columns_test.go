@@ -0,0 +1,117 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"testing"
+)
+
+func TestColumns_RoundTrip(t *testing.T) {
+	values := []Value{
+		MustFromString("0"),
+		MustFromString("-12345.6789 ISO4217-EUR"),
+		MustFromString("12345.6789 ISO4217-USD"),
+	}
+
+	for _, value := range values {
+		columns := NewColumns(value)
+		amount, err := columns.Amount.Value()
+		if err != nil {
+			t.Fatalf("AmountColumn.Value() failed: %v", err)
+		}
+		currency, err := columns.Currency.Value()
+		if err != nil {
+			t.Fatalf("CurrencyIDColumn.Value() failed: %v", err)
+		}
+
+		var got Columns
+		if err := got.Amount.Scan(amount); err != nil {
+			t.Errorf("AmountColumn.Scan(%v) failed: %v", amount, err)
+		}
+		if err := got.Currency.Scan(currency); err != nil {
+			t.Errorf("CurrencyIDColumn.Scan(%v) failed: %v", currency, err)
+		}
+		if !got.ToValue().Equal(value) {
+			t.Errorf("Columns roundtrip failed. Got %v, want %v", got.ToValue(), value)
+		}
+
+		codeColumns := NewCodeColumns(value)
+		if currency, err = codeColumns.Currency.Value(); err != nil {
+			t.Fatalf("CurrencyCodeColumn.Value() failed: %v", err)
+		}
+
+		var gotCode CodeColumns
+		if err := gotCode.Amount.Scan(amount); err != nil {
+			t.Errorf("AmountColumn.Scan(%v) failed: %v", amount, err)
+		}
+		if err := gotCode.Currency.Scan(currency); err != nil {
+			t.Errorf("CurrencyCodeColumn.Scan(%v) failed: %v", currency, err)
+		}
+		if !gotCode.ToValue().Equal(value) {
+			t.Errorf("CodeColumns roundtrip failed. Got %v, want %v", gotCode.ToValue(), value)
+		}
+	}
+}
+
+func TestCurrencyIDColumn_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    Currency
+		wantErr bool
+	}{
+		{"1", int64(42170978), ISO4217Currencies.ByCode("EUR"), false},
+		{"2", []byte("42170978"), ISO4217Currencies.ByCode("EUR"), false},
+		{"3", nil, nil, false},
+		{"4", int64(0), nil, false},
+		{"5", int64(1), nil, true},
+		{"6", int64(1 << 40), nil, true},
+		{"7", "abc", nil, true},
+		{"8", 1.5, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c CurrencyIDColumn
+			err := c.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CurrencyIDColumn.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if c.currency != tt.want {
+				t.Errorf("CurrencyIDColumn.Scan() = %v, want %v", c.currency, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyCodeColumn_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    Currency
+		wantErr bool
+	}{
+		{"1", "ISO4217-EUR", ISO4217Currencies.ByCode("EUR"), false},
+		{"2", []byte("ISO4217-EUR"), ISO4217Currencies.ByCode("EUR"), false},
+		{"3", nil, nil, false},
+		{"4", "", nil, false},
+		{"5", "ISO4217-ABC", nil, true},
+		{"6", int64(42170978), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c CurrencyCodeColumn
+			err := c.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CurrencyCodeColumn.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if c.currency != tt.want {
+				t.Errorf("CurrencyCodeColumn.Scan() = %v, want %v", c.currency, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseComposite parses the textual representation of a PostgreSQL composite value (row) like `(1.5,"a ""quoted"" field",)`.
+// It returns the fields of the composite, where NULL fields are nil.
+//
+// This follows the rules of PostgreSQL:
+//   - An empty unquoted field is NULL, an empty quoted field ("") is an empty string.
+//   - Inside of double quotes, commas and parentheses are part of the field, and "" is a literal double quote.
+//   - A backslash escapes the following character, inside and outside of double quotes.
+//   - Whitespace around the parentheses is ignored, whitespace inside of fields is preserved.
+func parseComposite(str string) ([]*string, error) {
+	trimmed := strings.TrimSpace(str)
+	offset := strings.Index(str, trimmed) // Offset of trimmed in str, used to report positions relative to the input.
+
+	if !strings.HasPrefix(trimmed, "(") {
+		return nil, fmt.Errorf("composite %q doesn't start with an opening parenthesis", str)
+	}
+
+	var fields []*string
+	var field strings.Builder
+	var quoted, inQuotes, closed bool
+
+	i := 1
+	for ; i < len(trimmed) && !closed; i++ {
+		c := trimmed[i]
+
+		switch {
+		case c == '\\':
+			// Escaped character, inside or outside of quotes.
+			if i+1 >= len(trimmed) {
+				return nil, fmt.Errorf("composite %q ends with an unfinished escape sequence at position %d", str, offset+i)
+			}
+			i++
+			field.WriteByte(trimmed[i])
+
+		case inQuotes && c == '"':
+			if i+1 < len(trimmed) && trimmed[i+1] == '"' {
+				// Doubled quote inside of quotes.
+				i++
+				field.WriteByte('"')
+			} else {
+				inQuotes = false
+			}
+
+		case inQuotes:
+			field.WriteByte(c)
+
+		case c == '"':
+			inQuotes, quoted = true, true
+
+		case c == ',' || c == ')':
+			if quoted || field.Len() > 0 {
+				s := field.String()
+				fields = append(fields, &s)
+			} else {
+				fields = append(fields, nil)
+			}
+			field.Reset()
+			quoted = false
+			closed = c == ')'
+
+		case c == '(':
+			return nil, fmt.Errorf("composite %q contains an unquoted opening parenthesis at position %d", str, offset+i)
+
+		default:
+			field.WriteByte(c)
+		}
+	}
+
+	switch {
+	case inQuotes:
+		return nil, fmt.Errorf("composite %q contains an unterminated quoted field", str)
+	case !closed:
+		return nil, fmt.Errorf("composite %q doesn't end with a closing parenthesis", str)
+	case i < len(trimmed):
+		return nil, fmt.Errorf("composite %q contains unexpected characters %q after the closing parenthesis at position %d", str, trimmed[i:], offset+i)
+	}
+
+	return fields, nil
+}
+
+// quoteCompositeField returns the given string as field of a PostgreSQL composite.
+// The field is only quoted if necessary, an empty string is quoted so that it isn't interpreted as NULL.
+func quoteCompositeField(str string) string {
+	if str != "" && !strings.ContainsAny(str, "\"\\,() \t\n\r") {
+		return str
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; c == '"' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(str[i])
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
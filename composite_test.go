@@ -0,0 +1,85 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_parseComposite(t *testing.T) {
+	field := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		str     string
+		want    []*string
+		wantErr bool
+	}{
+		{"1", `(1.5,42170978)`, []*string{field("1.5"), field("42170978")}, false},
+		{"2", `(1.5,)`, []*string{field("1.5"), nil}, false},
+		{"3", `(,)`, []*string{nil, nil}, false},
+		{"4", `()`, []*string{nil}, false},
+		{"5", `(1.5,"")`, []*string{field("1.5"), field("")}, false},
+		{"6", ` ( 1.5 , 2 ) `, []*string{field(" 1.5 "), field(" 2 ")}, false},
+		{"7", `("a,b","c)d")`, []*string{field("a,b"), field("c)d")}, false},
+		{"8", `("a ""quoted"" field",x)`, []*string{field(`a "quoted" field`), field("x")}, false},
+		{"9", `(a\,b,\"c\\)`, []*string{field("a,b"), field(`"c\`)}, false},
+		{"10", `("a\"b",c"d"e)`, []*string{field(`a"b`), field("cde")}, false},
+		{"11", `(1,2,3)`, []*string{field("1"), field("2"), field("3")}, false},
+		{"12", `1.5,2`, nil, true},
+		{"13", `(1.5,2`, nil, true},
+		{"14", `(1.5,"2)`, nil, true},
+		{"15", `(1.5,2)x`, nil, true},
+		{"16", `(1.5,(2))`, nil, true},
+		{"17", `(1.5,2\`, nil, true},
+		{"18", ``, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseComposite(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseComposite() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parseComposite() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_quoteCompositeField(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want string
+	}{
+		{"1", "ISO4217-EUR", "ISO4217-EUR"},
+		{"2", "", `""`},
+		{"3", "a,b", `"a,b"`},
+		{"4", `a "b" \c`, `"a \"b\" \\c"`},
+		{"5", "(a)", `"(a)"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := quoteCompositeField(tt.str)
+			if got != tt.want {
+				t.Errorf("quoteCompositeField() = %s, want %s", got, tt.want)
+			}
+
+			// Check roundtrip.
+			fields, err := parseComposite("(" + got + ")")
+			if err != nil {
+				t.Fatalf("parseComposite() failed: %v", err)
+			}
+			if len(fields) != 1 || fields[0] == nil || *fields[0] != tt.str {
+				t.Errorf("parseComposite() = %v, want %q", fields, tt.str)
+			}
+		})
+	}
+}
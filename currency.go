@@ -38,6 +38,11 @@ func ValidateCurrency(c Currency) error {
 		return &ErrorInvalidCurrency{"unique ID is 0. This value is reserved for \"no currency\""}
 	}
 
+	// Unique IDs from 0x71000000 to 0x71FFFFFF would collide with the version byte of the binary format.
+	if uniqueID := c.UniqueID(); isBinaryReservedUniqueID(uniqueID) {
+		return &ErrorInvalidCurrency{fmt.Sprintf("unique ID %d (%#x) is in the range 0x71000000 to 0x71FFFFFF, which is reserved for the binary format", uniqueID, uniqueID)}
+	}
+
 	// The code should only contain alphanumeric characters.
 	if regexFindNonAlphaNumeric.MatchString(code) {
 		firstMatch := regexFindNonAlphaNumeric.FindString(code)
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	money "github.com/Dadido3/D3money"
+	"github.com/Dadido3/D3money/pgsql"
 	"github.com/google/go-cmp/cmp"
 	"github.com/shopspring/decimal"
 )
@@ -70,7 +71,7 @@ func TestCompositeType(t *testing.T) {
 	}
 
 	// Create custom composite type.
-	if err := gormDB.Exec("DROP TYPE IF EXISTS d3money; CREATE TYPE d3money AS (amount DECIMAL, currency INTEGER);").Error; err != nil {
+	if err := gormDB.Exec("DROP TYPE IF EXISTS d3money; " + (pgsql.Schema{}).CreateType()).Error; err != nil {
 		t.Fatalf("Failed to create d3money composite type: %v", err)
 	}
 
@@ -142,3 +143,173 @@ func TestCompositeType(t *testing.T) {
 	}
 
 }
+
+// TestNullValue tests storing nullable monetary values.
+func TestNullValue(t *testing.T) {
+	// Delete tables.
+	if err := gormDB.Migrator().DropTable(&TestAccountNullable{}); err != nil {
+		t.Fatalf("Failed to drop tables: %v", err)
+	}
+
+	// Create tables from structures.
+	if err := gormDB.AutoMigrate(&TestAccountNullable{}); err != nil {
+		t.Fatalf("GORM auto migration failed: %v", err)
+	}
+
+	accounts := []TestAccountNullable{
+		{Balance: money.NewNullValue(money.FromDecimal(decimal.New(int64(rand.Intn(100000000)), -4), money.ISO4217Currencies.ByCode("EUR")))},
+		{Balance: money.NewNullValue(money.FromDecimal(decimal.New(int64(rand.Intn(100000000)), -4), nil))},
+		{Balance: money.NullValue{}},
+	}
+
+	for i := range accounts {
+		if err := gormDB.Create(&accounts[i]).Error; err != nil {
+			t.Errorf("Failed to create account %d: %v", i+1, err)
+		}
+	}
+
+	for i, account := range accounts {
+		var read TestAccountNullable
+		if err := gormDB.First(&read, account.ID).Error; err != nil {
+			t.Errorf("Failed to query account %d: %v", i+1, err)
+			continue
+		}
+
+		if read.Balance.Valid != account.Balance.Valid {
+			t.Errorf("Queried balance %v doesn't match written balance %v", read.Balance, account.Balance)
+		} else if equal, err := account.Balance.V.EqualDetailed(read.Balance.V); err != nil {
+			t.Errorf("account.Balance.V.EqualDetailed(read.Balance.V) returned error: %v", err)
+		} else if !equal {
+			t.Errorf("Queried balance %v doesn't match written balance %v", read.Balance, account.Balance)
+		}
+	}
+
+	// The NULL value must be stored as SQL NULL.
+	var count int64
+	if err := gormDB.Model(&TestAccountNullable{}).Where("balance IS NULL").Count(&count).Error; err != nil {
+		t.Errorf("Failed to count NULL balances: %v", err)
+	} else if count != 1 {
+		t.Errorf("Got %d NULL balances, want 1", count)
+	}
+}
+
+// TestColumns tests storing monetary values as two separate columns.
+func TestColumns(t *testing.T) {
+	// Delete tables.
+	if err := gormDB.Migrator().DropTable(&TestAccountColumns{}); err != nil {
+		t.Fatalf("Failed to drop tables: %v", err)
+	}
+
+	// Create tables from structures.
+	if err := gormDB.AutoMigrate(&TestAccountColumns{}); err != nil {
+		t.Fatalf("GORM auto migration failed: %v", err)
+	}
+
+	for _, column := range []string{"balance_amount", "balance_currency", "limit_amount", "limit_currency"} {
+		if !gormDB.Migrator().HasColumn(&TestAccountColumns{}, column) {
+			t.Errorf("Column %q doesn't exist", column)
+		}
+	}
+
+	accounts := []TestAccountColumns{
+		{Balance: money.NewColumns(money.MustFromString("12.3456 ISO4217-EUR")), Limit: money.NewCodeColumns(money.MustFromString("100 ISO4217-EUR"))},
+		{Balance: money.NewColumns(money.MustFromString("-2.5 ISO4217-EUR")), Limit: money.NewCodeColumns(money.MustFromString("50.25"))},
+		{Balance: money.NewColumns(money.MustFromString("7")), Limit: money.NewCodeColumns(money.MustFromString("0 ISO4217-USD"))},
+	}
+
+	for i := range accounts {
+		if err := gormDB.Create(&accounts[i]).Error; err != nil {
+			t.Errorf("Failed to create account %d: %v", i+1, err)
+		}
+	}
+
+	for i, account := range accounts {
+		var read TestAccountColumns
+		if err := gormDB.First(&read, account.ID).Error; err != nil {
+			t.Errorf("Failed to query account %d: %v", i+1, err)
+			continue
+		}
+
+		if equal, err := account.Balance.ToValue().EqualDetailed(read.Balance.ToValue()); err != nil {
+			t.Errorf("Balance of account %d: EqualDetailed() returned error: %v", i+1, err)
+		} else if !equal {
+			t.Errorf("Queried balance %v doesn't match written balance %v", read.Balance.ToValue(), account.Balance.ToValue())
+		}
+		if equal, err := account.Limit.ToValue().EqualDetailed(read.Limit.ToValue()); err != nil {
+			t.Errorf("Limit of account %d: EqualDetailed() returned error: %v", i+1, err)
+		} else if !equal {
+			t.Errorf("Queried limit %v doesn't match written limit %v", read.Limit.ToValue(), account.Limit.ToValue())
+		}
+	}
+
+	// Aggregate the amounts in SQL.
+	var sum decimal.Decimal
+	eur := money.ISO4217Currencies.ByCode("EUR")
+	if err := gormDB.Model(&TestAccountColumns{}).Select("SUM(balance_amount)").Where("balance_currency = ?", eur.UniqueID()).Scan(&sum).Error; err != nil {
+		t.Errorf("Failed to sum balances: %v", err)
+	} else if want := decimal.RequireFromString("9.8456"); !sum.Equal(want) {
+		t.Errorf("Sum of balances is %v, want %v", sum, want)
+	}
+
+	// Range queries on the amounts.
+	var count int64
+	if err := gormDB.Model(&TestAccountColumns{}).Where("limit_amount > ? AND limit_currency = ?", 50, "ISO4217-EUR").Count(&count).Error; err != nil {
+		t.Errorf("Failed to count accounts: %v", err)
+	} else if count != 1 {
+		t.Errorf("Got %d accounts, want 1", count)
+	}
+}
+
+// TestStorageFormats tests storing monetary values in the different storage formats.
+func TestStorageFormats(t *testing.T) {
+	// Delete tables.
+	if err := gormDB.Migrator().DropTable(&TestAccountFormats{}); err != nil {
+		t.Fatalf("Failed to drop tables: %v", err)
+	}
+
+	// Create tables from structures.
+	if err := gormDB.AutoMigrate(&TestAccountFormats{}); err != nil {
+		t.Fatalf("GORM auto migration failed: %v", err)
+	}
+
+	values := []money.Value{
+		money.FromDecimal(decimal.New(int64(rand.Intn(100000000)), -4), money.ISO4217Currencies.ByCode("EUR")),
+		money.FromDecimal(decimal.New(int64(rand.Intn(100000000)), -4), nil),
+	}
+
+	for i, value := range values {
+		account := TestAccountFormats{
+			BalanceID:     value,
+			BalanceCode:   money.UniqueCodeValue{V: value},
+			BalanceString: money.StringValue{V: value},
+			BalanceJSON:   money.JSONValue{V: value},
+		}
+		if err := gormDB.Create(&account).Error; err != nil {
+			t.Fatalf("Failed to create account %d: %v", i+1, err)
+		}
+
+		var read TestAccountFormats
+		if err := gormDB.First(&read, account.ID).Error; err != nil {
+			t.Fatalf("Failed to query account %d: %v", i+1, err)
+		}
+
+		for name, got := range map[string]money.Value{"ID": read.BalanceID, "Code": read.BalanceCode.V, "String": read.BalanceString.V, "JSON": read.BalanceJSON.V} {
+			if equal, err := value.EqualDetailed(got); err != nil {
+				t.Errorf("Balance%s of account %d: EqualDetailed() returned error: %v", name, i+1, err)
+			} else if !equal {
+				t.Errorf("Queried Balance%s %v doesn't match written balance %v", name, got, value)
+			}
+		}
+
+		// Every column can be read as plain value, the format is detected automatically.
+		var plain [4]money.Value
+		if err := gormDB.Raw("SELECT balance_id, balance_code, balance_string, balance_json FROM test_account_formats WHERE id = ?", account.ID).Row().Scan(&plain[0], &plain[1], &plain[2], &plain[3]); err != nil {
+			t.Fatalf("Failed to query plain values of account %d: %v", i+1, err)
+		}
+		for j, got := range plain {
+			if !value.Equal(got) {
+				t.Errorf("Queried plain value %v of column %d doesn't match written balance %v", got, j, value)
+			}
+		}
+	}
+}
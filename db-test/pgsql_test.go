@@ -0,0 +1,187 @@
+package dbt
+
+import (
+	"context"
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/Dadido3/D3money/pgsql"
+)
+
+// TestPgsqlSchema tests the generated DDL for the composite type, domain, functions and currency lookup table.
+func TestPgsqlSchema(t *testing.T) {
+	// This works only with PostgreSQL.
+	if *flagDBDriver != "pgx" {
+		t.SkipNow()
+	}
+
+	schema := pgsql.Schema{TypeName: "test_d3money", CurrenciesTable: "test_currencies"}
+
+	// Drop and create all objects.
+	for _, statement := range append(schema.DropStatements(), schema.Statements()...) {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("Failed to execute %q: %v", statement, err)
+		}
+	}
+	defer func() {
+		for _, statement := range schema.DropStatements() {
+			db.Exec(statement)
+		}
+	}()
+
+	// Running the insert again updates the existing rows.
+	if _, err := db.Exec(schema.InsertCurrencies()); err != nil {
+		t.Fatalf("Failed to insert currencies again: %v", err)
+	}
+
+	if _, err := db.Exec("DROP TABLE IF EXISTS test_pgsql_accounts"); err != nil {
+		t.Fatalf("Failed to drop table: %v", err)
+	}
+	if _, err := db.Exec("CREATE TABLE test_pgsql_accounts (id INTEGER, balance test_d3money_checked)"); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+
+	values := []money.Value{
+		money.MustFromString("12.34 ISO4217-EUR"),
+		money.MustFromString("-2.34 ISO4217-EUR"),
+		money.MustFromString("100 ISO4217-USD"),
+	}
+	for i, value := range values {
+		if _, err := db.Exec("INSERT INTO test_pgsql_accounts (id, balance) VALUES ($1, $2)", i, value); err != nil {
+			t.Fatalf("Failed to insert entry %d: %v", i, err)
+		}
+	}
+
+	// The domain rejects unknown currencies and missing amounts.
+	if _, err := db.Exec("INSERT INTO test_pgsql_accounts (id, balance) VALUES (100, '(1,12345)')"); err == nil {
+		t.Errorf("Inserting a value with unknown currency didn't fail")
+	}
+	if _, err := db.Exec("INSERT INTO test_pgsql_accounts (id, balance) VALUES (100, '(,42170978)')"); err == nil {
+		t.Errorf("Inserting a value without amount didn't fail")
+	}
+
+	// Sum the values of a single currency.
+	var sum money.Value
+	if err := db.QueryRow("SELECT test_d3money_sum(balance) FROM test_pgsql_accounts WHERE (balance).currency = $1", 42170978).Scan(&sum); err != nil {
+		t.Fatalf("Failed to query sum: %v", err)
+	}
+	if want := money.MustFromString("10 ISO4217-EUR"); !sum.Equal(want) {
+		t.Errorf("Queried sum %v, want %v", sum, want)
+	}
+
+	// Summing different currencies fails.
+	if err := db.QueryRow("SELECT test_d3money_sum(balance) FROM test_pgsql_accounts").Scan(&sum); err == nil {
+		t.Errorf("Summing different currencies didn't fail")
+	}
+
+	// Join on the lookup table.
+	var code string
+	if err := db.QueryRow("SELECT c.unique_code FROM test_pgsql_accounts a JOIN test_currencies c ON c.unique_id = (a.balance).currency WHERE a.id = $1", 2).Scan(&code); err != nil {
+		t.Fatalf("Failed to query joined currency: %v", err)
+	}
+	if code != "ISO4217-USD" {
+		t.Errorf("Queried currency code %q, want %q", code, "ISO4217-USD")
+	}
+}
+
+// TestPgsqlOperators tests the generated aggregates, comparison operators and arithmetic operators.
+func TestPgsqlOperators(t *testing.T) {
+	// This works only with PostgreSQL.
+	if *flagDBDriver != "pgx" {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	schema := pgsql.Schema{TypeName: "test_d3money_ops", CurrenciesTable: "test_currencies_ops"}
+
+	for _, statement := range append(schema.DropStatements(), "DROP TABLE IF EXISTS test_pgsql_operators") {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("Failed to execute %q: %v", statement, err)
+		}
+	}
+	defer func() {
+		for _, statement := range schema.DropStatements() {
+			db.Exec(statement)
+		}
+	}()
+
+	// Install everything in a transaction.
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to begin transaction: %v", err)
+	}
+	if err := schema.Install(ctx, tx); err != nil {
+		tx.Rollback()
+		t.Fatalf("Schema.Install() failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Failed to commit transaction: %v", err)
+	}
+
+	if _, err := db.Exec("CREATE TABLE test_pgsql_operators (id INTEGER, balance test_d3money_ops)"); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+
+	values := []money.Value{
+		money.MustFromString("12.34 ISO4217-EUR"),
+		money.MustFromString("-2.34 ISO4217-EUR"),
+		money.MustFromString("5 ISO4217-EUR"),
+		money.MustFromString("100 ISO4217-USD"),
+	}
+	for i, value := range values {
+		if _, err := db.Exec("INSERT INTO test_pgsql_operators (id, balance) VALUES ($1, $2)", i, value); err != nil {
+			t.Fatalf("Failed to insert entry %d: %v", i, err)
+		}
+	}
+	if _, err := db.Exec("INSERT INTO test_pgsql_operators (id, balance) VALUES ($1, NULL)", len(values)); err != nil {
+		t.Fatalf("Failed to insert NULL entry: %v", err)
+	}
+
+	// Aggregates ignore NULL values.
+	aggregates := []struct {
+		query string
+		want  money.Value
+	}{
+		{"SELECT SUM(balance) FROM test_pgsql_operators WHERE id <> 3", money.MustFromString("15 ISO4217-EUR")},
+		{"SELECT MIN(balance) FROM test_pgsql_operators WHERE id <> 3", money.MustFromString("-2.34 ISO4217-EUR")},
+		{"SELECT MAX(balance) FROM test_pgsql_operators WHERE id <> 3", money.MustFromString("12.34 ISO4217-EUR")},
+		{"SELECT test_d3money_ops_sum(balance) FROM test_pgsql_operators WHERE id = 3", money.MustFromString("100 ISO4217-USD")},
+		{"SELECT a.balance + b.balance FROM test_pgsql_operators a, test_pgsql_operators b WHERE a.id = 0 AND b.id = 1", money.MustFromString("10 ISO4217-EUR")},
+		{"SELECT a.balance - b.balance FROM test_pgsql_operators a, test_pgsql_operators b WHERE a.id = 0 AND b.id = 1", money.MustFromString("14.68 ISO4217-EUR")},
+		{"SELECT -balance FROM test_pgsql_operators WHERE id = 0", money.MustFromString("-12.34 ISO4217-EUR")},
+		{"SELECT balance * 2 FROM test_pgsql_operators WHERE id = 0", money.MustFromString("24.68 ISO4217-EUR")},
+		{"SELECT 0.5 * balance FROM test_pgsql_operators WHERE id = 3", money.MustFromString("50 ISO4217-USD")},
+	}
+	for _, aggregate := range aggregates {
+		var got money.Value
+		if err := db.QueryRow(aggregate.query).Scan(&got); err != nil {
+			t.Errorf("Query %q failed: %v", aggregate.query, err)
+		} else if !got.Equal(aggregate.want) {
+			t.Errorf("Query %q returned %v, want %v", aggregate.query, got, aggregate.want)
+		}
+	}
+
+	// Comparisons with values of the same currency.
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM test_pgsql_operators WHERE id <> 3 AND balance >= $1", money.MustFromString("5 ISO4217-EUR")).Scan(&count); err != nil {
+		t.Errorf("Failed to count balances: %v", err)
+	} else if count != 2 {
+		t.Errorf("Got %d balances >= 5 EUR, want 2", count)
+	}
+
+	// Mixed currencies result in an error.
+	failing := []string{
+		"SELECT SUM(balance) FROM test_pgsql_operators",
+		"SELECT MIN(balance) FROM test_pgsql_operators",
+		"SELECT MAX(balance) FROM test_pgsql_operators",
+		"SELECT COUNT(*) FROM test_pgsql_operators WHERE balance > '(0,42170978)'",
+		"SELECT COUNT(*) FROM test_pgsql_operators WHERE balance = '(100,)'",
+		"SELECT a.balance + b.balance FROM test_pgsql_operators a, test_pgsql_operators b WHERE a.id = 0 AND b.id = 3",
+	}
+	for _, query := range failing {
+		var got interface{}
+		if err := db.QueryRow(query).Scan(&got); err == nil {
+			t.Errorf("Query %q didn't fail", query)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package dbt
+
+import (
+	"context"
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/Dadido3/D3money/pgxmoney"
+	"github.com/jackc/pgx/v5"
+)
+
+// TestPgxCodec tests storing monetary values as composite type with the native pgx codec.
+func TestPgxCodec(t *testing.T) {
+	// This works only with PostgreSQL.
+	if *flagDBDriver != "pgx" {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, *flagDBDataSourceName)
+	if err != nil {
+		t.Fatalf("pgx.Connect() failed: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	// Create custom composite type and table.
+	if _, err := conn.Exec(ctx, "DROP TABLE IF EXISTS test_pgx_accounts; DROP TYPE IF EXISTS d3money CASCADE; CREATE TYPE d3money AS (amount DECIMAL, currency INTEGER);"); err != nil {
+		t.Fatalf("Failed to create d3money composite type: %v", err)
+	}
+	if _, err := conn.Exec(ctx, "CREATE TABLE test_pgx_accounts (id INTEGER, balance d3money, balance_amount NUMERIC, balance_currency INTEGER)"); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	defer conn.Exec(ctx, "DROP TABLE IF EXISTS test_pgx_accounts")
+
+	if err := pgxmoney.Register(ctx, conn); err != nil {
+		t.Fatalf("pgxmoney.Register() failed: %v", err)
+	}
+
+	values := []money.Value{
+		money.MustFromString("-12345.6789 ISO4217-EUR"),
+		money.MustFromString("12345.6789"),
+		money.MustFromString("0 ISO4217-USD"),
+	}
+
+	for i, value := range values {
+		amount, currency := pgxmoney.Columns(value)
+		if _, err := conn.Exec(ctx, "INSERT INTO test_pgx_accounts (id, balance, balance_amount, balance_currency) VALUES ($1, $2, $3, $4)", i, value, amount, currency); err != nil {
+			t.Fatalf("Failed to insert entry %d: %v", i, err)
+		}
+	}
+
+	// Insert a NULL value.
+	if _, err := conn.Exec(ctx, "INSERT INTO test_pgx_accounts (id, balance) VALUES ($1, $2)", len(values), (*money.Value)(nil)); err != nil {
+		t.Fatalf("Failed to insert NULL entry: %v", err)
+	}
+
+	// Read values in binary and text format.
+	for _, mode := range []pgx.QueryExecMode{pgx.QueryExecModeCacheStatement, pgx.QueryExecModeSimpleProtocol} {
+		for i, value := range values {
+			var balance, pair money.Value
+			amount, currency := pgxmoney.ScanColumns(&pair)
+			if err := conn.QueryRow(ctx, "SELECT balance, balance_amount, balance_currency FROM test_pgx_accounts WHERE id = $1", mode, i).Scan(&balance, amount, currency); err != nil {
+				t.Fatalf("Failed to query entry %d with mode %v: %v", i, mode, err)
+			}
+			if !balance.Equal(value) {
+				t.Errorf("Queried balance %v doesn't match written balance %v", balance, value)
+			}
+			if !pair.Equal(value) {
+				t.Errorf("Queried column pair %v doesn't match written balance %v", pair, value)
+			}
+		}
+
+		var balance *money.Value
+		if err := conn.QueryRow(ctx, "SELECT balance FROM test_pgx_accounts WHERE id = $1", mode, len(values)).Scan(&balance); err != nil {
+			t.Fatalf("Failed to query NULL entry with mode %v: %v", mode, err)
+		}
+		if balance != nil {
+			t.Errorf("Queried balance %v, want NULL", balance)
+		}
+	}
+}
@@ -21,3 +21,31 @@ type TestAccountCompositeType struct {
 
 	Balance money.Value `gorm:"type:d3money"`
 }
+
+type TestAccountNullable struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Balance money.NullValue
+}
+
+type TestAccountColumns struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Balance money.Columns     `gorm:"embedded;embeddedPrefix:balance_;precision:20;scale:4"`
+	Limit   money.CodeColumns `gorm:"embedded;embeddedPrefix:limit_;precision:12;scale:2"`
+}
+
+type TestAccountFormats struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	BalanceID     money.Value
+	BalanceCode   money.UniqueCodeValue
+	BalanceString money.StringValue
+	BalanceJSON   money.JSONValue
+}
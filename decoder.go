@@ -0,0 +1,157 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"fmt"
+)
+
+// Decoder decodes monetary values and looks up their currencies in a specific collection.
+//
+// The decoding methods of Value always use the global Currencies collection.
+// A decoder allows to decode values against a scoped collection instead, e.g. one per tenant, without modifying global state:
+//
+//	tenantCurrencies := money.MustNewCurrencyCollection("Tenant", "", money.ISO4217Currencies.All(), customCurrencies)
+//	decoder := money.NewDecoder(tenantCurrencies)
+//	value, err := decoder.DecodeJSON(data)
+//
+// The zero value is valid and uses the global Currencies collection.
+type Decoder struct {
+	Currencies *CurrencyCollection // Currencies is the collection that currencies are looked up in. Defaults to the global Currencies.
+}
+
+// NewDecoder returns a decoder that looks up currencies in the given collection.
+func NewDecoder(cc *CurrencyCollection) Decoder {
+	return Decoder{Currencies: cc}
+}
+
+func (d Decoder) currencies() *CurrencyCollection {
+	if d.Currencies == nil {
+		return Currencies
+	}
+	return d.Currencies
+}
+
+// DecodeJSON returns the value of the given JSON representation, as written by Value.MarshalJSON.
+func (d Decoder) DecodeJSON(data []byte) (Value, error) {
+	amount, cur, err := unmarshalJSON(data, d.currencies())
+	if err != nil {
+		return Value{}, err
+	}
+
+	return Value{amount: amount, currency: cur}, nil
+}
+
+// DecodeBinary returns the value of the given binary representation, as written by Value.MarshalBinary.
+func (d Decoder) DecodeBinary(data []byte) (Value, error) {
+	amount, cur, err := unmarshalBinary(data, d.currencies())
+	if err != nil {
+		return Value{}, err
+	}
+
+	return Value{amount: amount, currency: cur}, nil
+}
+
+// DecodeMsgpack returns the value of the given MessagePack extension object, as written by Value.MarshalMsgpack.
+func (d Decoder) DecodeMsgpack(data []byte) (Value, error) {
+	amount, cur, err := unmarshalMsgpack(data, d.currencies())
+	if err != nil {
+		return Value{}, err
+	}
+
+	return Value{amount: amount, currency: cur}, nil
+}
+
+// DecodeCBOR returns the value of the given CBOR data item, as written by Value.MarshalCBOR.
+func (d Decoder) DecodeCBOR(data []byte) (Value, error) {
+	amount, cur, err := unmarshalCBOR(data, d.currencies())
+	if err != nil {
+		return Value{}, err
+	}
+
+	return Value{amount: amount, currency: cur}, nil
+}
+
+// DecodeText returns the value of the given text representation, as written by Value.MarshalText.
+//
+//	NewDecoder(ISO4217Currencies).DecodeText([]byte("-12.34 ISO4217-EUR")) // Returns -12.34 EUR.
+//	NewDecoder(ISO4217Currencies).DecodeText([]byte("-12.34 FOO-BAR"))     // Returns an error, as FOO-BAR is not part of the collection.
+func (d Decoder) DecodeText(text []byte) (Value, error) {
+	amount, cur, err := parse(string(text), d.currencies(), nil)
+	if err != nil {
+		return Value{}, fmt.Errorf("failed to parse text %q: %w", string(text), err)
+	}
+
+	return Value{amount: amount, currency: cur}, nil
+}
+
+// Scan returns the value of the given database representation.
+// See Value.Scan for the supported formats.
+func (d Decoder) Scan(value interface{}) (Value, error) {
+	amount, cur, err := scanValue(value, d.currencies())
+	if err != nil {
+		return Value{}, err
+	}
+
+	return Value{amount: amount, currency: cur}, nil
+}
+
+// Into returns a decoding target for v.
+// The target can be passed to functions that expect an unmarshaler or scanner, and it writes the decoded value into v:
+//
+//	var value money.Value
+//	err := row.Scan(decoder.Into(&value))
+//	err := json.Unmarshal(data, decoder.Into(&value))
+func (d Decoder) Into(v *Value) *DecoderTarget {
+	return &DecoderTarget{decoder: d, v: v}
+}
+
+// DecoderTarget decodes into a value by using the collection of a decoder.
+// It implements json.Unmarshaler, encoding.TextUnmarshaler, encoding.BinaryUnmarshaler and sql.Scanner, as well as the MessagePack and CBOR unmarshaler methods of Value.
+type DecoderTarget struct {
+	decoder Decoder
+	v       *Value
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *DecoderTarget) UnmarshalJSON(data []byte) error {
+	return t.set(t.decoder.DecodeJSON(data))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *DecoderTarget) UnmarshalText(text []byte) error {
+	return t.set(t.decoder.DecodeText(text))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (t *DecoderTarget) UnmarshalBinary(data []byte) error {
+	return t.set(t.decoder.DecodeBinary(data))
+}
+
+// UnmarshalMsgpack decodes a MessagePack extension object, see Value.UnmarshalMsgpack.
+func (t *DecoderTarget) UnmarshalMsgpack(data []byte) error {
+	return t.set(t.decoder.DecodeMsgpack(data))
+}
+
+// UnmarshalCBOR decodes a CBOR data item, see Value.UnmarshalCBOR.
+func (t *DecoderTarget) UnmarshalCBOR(data []byte) error {
+	return t.set(t.decoder.DecodeCBOR(data))
+}
+
+// Scan implements the sql.Scanner interface.
+func (t *DecoderTarget) Scan(value interface{}) error {
+	return t.set(t.decoder.Scan(value))
+}
+
+// set writes the given value into the target, unless there is an error.
+func (t *DecoderTarget) set(v Value, err error) error {
+	if err != nil {
+		return err
+	}
+
+	*t.v = v
+	return nil
+}
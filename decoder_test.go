@@ -0,0 +1,139 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDecoder(t *testing.T) {
+	cc := MustNewCurrencyCollection("Tenant", "", []Currency{testCurrency1, ISO4217Currencies.ByCode("EUR")})
+	decoder := NewDecoder(cc)
+
+	values := []Value{
+		FromDecimal(decimal.RequireFromString("-12.34"), testCurrency1),
+		MustFromString("12.34 ISO4217-EUR"),
+		MustFromString("12.34"),
+	}
+
+	for _, value := range values {
+		// JSON.
+		data, err := value.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%v.MarshalJSON() failed: %v", value, err)
+		}
+		if got, err := decoder.DecodeJSON(data); err != nil {
+			t.Errorf("Decoder.DecodeJSON(%s) failed: %v", data, err)
+		} else if !got.Equal(value) {
+			t.Errorf("Decoder.DecodeJSON(%s) = %v, want %v", data, got, value)
+		}
+
+		// Binary.
+		if data, err = value.MarshalBinary(); err != nil {
+			t.Fatalf("%v.MarshalBinary() failed: %v", value, err)
+		}
+		if got, err := decoder.DecodeBinary(data); err != nil {
+			t.Errorf("Decoder.DecodeBinary(%v) failed: %v", data, err)
+		} else if !got.Equal(value) {
+			t.Errorf("Decoder.DecodeBinary(%v) = %v, want %v", data, got, value)
+		}
+
+		// MessagePack.
+		if data, err = value.MarshalMsgpack(); err != nil {
+			t.Fatalf("%v.MarshalMsgpack() failed: %v", value, err)
+		}
+		if got, err := decoder.DecodeMsgpack(data); err != nil {
+			t.Errorf("Decoder.DecodeMsgpack(%x) failed: %v", data, err)
+		} else if !got.Equal(value) {
+			t.Errorf("Decoder.DecodeMsgpack(%x) = %v, want %v", data, got, value)
+		}
+
+		// CBOR.
+		if data, err = value.MarshalCBOR(); err != nil {
+			t.Fatalf("%v.MarshalCBOR() failed: %v", value, err)
+		}
+		if got, err := decoder.DecodeCBOR(data); err != nil {
+			t.Errorf("Decoder.DecodeCBOR(%x) failed: %v", data, err)
+		} else if !got.Equal(value) {
+			t.Errorf("Decoder.DecodeCBOR(%x) = %v, want %v", data, got, value)
+		}
+
+		// Text.
+		if data, err = value.MarshalText(); err != nil {
+			t.Fatalf("%v.MarshalText() failed: %v", value, err)
+		}
+		if got, err := decoder.DecodeText(data); err != nil {
+			t.Errorf("Decoder.DecodeText(%q) failed: %v", data, err)
+		} else if !got.Equal(value) {
+			t.Errorf("Decoder.DecodeText(%q) = %v, want %v", data, got, value)
+		}
+
+		// Database.
+		dbValue, err := value.Value()
+		if err != nil {
+			t.Fatalf("%v.Value() failed: %v", value, err)
+		}
+		if got, err := decoder.Scan(dbValue); err != nil {
+			t.Errorf("Decoder.Scan(%v) failed: %v", dbValue, err)
+		} else if !got.Equal(value) {
+			t.Errorf("Decoder.Scan(%v) = %v, want %v", dbValue, got, value)
+		}
+	}
+
+	// The custom currency isn't part of the global collection.
+	var v Value
+	if err := v.UnmarshalText([]byte("-12.34 FOO-BAR")); err == nil {
+		t.Errorf("Value.UnmarshalText() with currency of scoped collection didn't fail")
+	}
+
+	// Currencies that are not part of the collection can't be decoded.
+	if _, err := decoder.DecodeText([]byte("12.34 ISO4217-USD")); err == nil {
+		t.Errorf("Decoder.DecodeText() with currency outside of the collection didn't fail")
+	}
+	if _, err := decoder.Scan("(12.34,42170840)"); err == nil {
+		t.Errorf("Decoder.Scan() with currency outside of the collection didn't fail")
+	}
+
+	// The zero value uses the global collection.
+	if got, err := (Decoder{}).DecodeText([]byte("12.34 ISO4217-USD")); err != nil || !got.Equal(MustFromString("12.34 ISO4217-USD")) {
+		t.Errorf("Decoder{}.DecodeText() = %v, %v, want 12.34 ISO4217-USD", got, err)
+	}
+}
+
+func TestDecoder_Into(t *testing.T) {
+	decoder := NewDecoder(MustNewCurrencyCollection("Tenant", "", []Currency{testCurrency1}))
+
+	var prices struct {
+		Net   Value
+		Gross Value
+	}
+	data := []byte(`{"Net":{"Amount":"10","Currency":"FOO-BAR"},"Gross":{"Amount":"11.9","Currency":"FOO-BAR"}}`)
+
+	target := struct {
+		Net   *DecoderTarget
+		Gross *DecoderTarget
+	}{decoder.Into(&prices.Net), decoder.Into(&prices.Gross)}
+
+	if err := json.Unmarshal(data, &target); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	if want := FromDecimal(decimal.New(10, 0), testCurrency1); !prices.Net.Equal(want) {
+		t.Errorf("Net = %v, want %v", prices.Net, want)
+	}
+	if want := FromDecimal(decimal.New(119, -1), testCurrency1); !prices.Gross.Equal(want) {
+		t.Errorf("Gross = %v, want %v", prices.Gross, want)
+	}
+
+	var v Value
+	if err := decoder.Into(&v).Scan([]byte("(1.5,FOO-BAR)")); err != nil {
+		t.Errorf("DecoderTarget.Scan() failed: %v", err)
+	} else if want := FromDecimal(decimal.New(15, -1), testCurrency1); !v.Equal(want) {
+		t.Errorf("DecoderTarget.Scan() = %v, want %v", v, want)
+	}
+}
@@ -42,3 +42,56 @@ type ErrorCantFindUniqueCode struct{ uniqueCode string }
 func (e *ErrorCantFindUniqueCode) Error() string {
 	return fmt.Sprintf("can't find currency with unique code %q", e.uniqueCode)
 }
+
+// ErrorCantFindCode is returned when no currency can be found for a given code.
+type ErrorCantFindCode struct{ code string }
+
+func (e *ErrorCantFindCode) Error() string {
+	return fmt.Sprintf("can't find currency with code %q", e.code)
+}
+
+// ErrorMissingField is returned by strict JSON unmarshalling when a required field is missing.
+type ErrorMissingField struct{ field string }
+
+func (e *ErrorMissingField) Error() string {
+	return fmt.Sprintf("missing field %q", e.field)
+}
+
+// Field returns the name of the missing field.
+func (e *ErrorMissingField) Field() string { return e.field }
+
+// ErrorUnknownField is returned by strict JSON unmarshalling when the data contains an unknown field.
+type ErrorUnknownField struct{ field string }
+
+func (e *ErrorUnknownField) Error() string {
+	return fmt.Sprintf("unknown field %q", e.field)
+}
+
+// Field returns the name of the unknown field.
+func (e *ErrorUnknownField) Field() string { return e.field }
+
+// ErrorInvalidAmountType is returned by strict JSON unmarshalling when the amount has the wrong JSON type, e.g. a number instead of a string.
+type ErrorInvalidAmountType struct{ got, want string }
+
+func (e *ErrorInvalidAmountType) Error() string {
+	return fmt.Sprintf("amount is a JSON %s, expected a JSON %s", e.got, e.want)
+}
+
+// Got returns the JSON type of the amount, e.g. "number", "string", "boolean", "object" or "array".
+func (e *ErrorInvalidAmountType) Got() string { return e.got }
+
+// Want returns the expected JSON type of the amount, either "number" or "string".
+func (e *ErrorInvalidAmountType) Want() string { return e.want }
+
+// ErrorExceedsSmallestUnit is returned when the amount of a value has more precision than the smallest unit of its currency allows.
+type ErrorExceedsSmallestUnit struct{ value Value }
+
+func (e *ErrorExceedsSmallestUnit) Error() string {
+	return fmt.Sprintf("amount %s is not a multiple of the smallest unit %s of currency %s", e.value.amount, e.value.currency.SmallestUnit().amount, helperCurrencyUniqueCode(e.value.currency))
+}
+
+// Value returns the value whose amount exceeds the precision of the smallest unit.
+func (e *ErrorExceedsSmallestUnit) Value() Value { return e.value }
+
+// SmallestUnit returns the smallest unit of the currency of the value.
+func (e *ErrorExceedsSmallestUnit) SmallestUnit() Value { return e.value.currency.SmallestUnit() }
@@ -0,0 +1,162 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package finance contains financial calculations like loan amortization and interest accrual on monetary values.
+package finance
+
+import (
+	"fmt"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/shopspring/decimal"
+)
+
+// Frequency is the number of payments or compounding periods per year.
+type Frequency int
+
+const (
+	Annually     Frequency = 1
+	SemiAnnually Frequency = 2
+	Quarterly    Frequency = 4
+	Monthly      Frequency = 12
+	BiWeekly     Frequency = 26
+	Weekly       Frequency = 52
+)
+
+// AmortizationMethod defines how a loan is repaid.
+type AmortizationMethod int
+
+const (
+	Annuity AmortizationMethod = iota // All installments have the same amount, the share of the principal increases over time. This is the default.
+	Linear                            // All principal repayments have the same amount, the installments decrease over time.
+)
+
+// precision is the number of decimal places that is used for intermediate results that can't be represented exactly.
+// All amounts that are part of a schedule are rounded to the smallest unit of the currency, so this only has to be large enough to not influence the rounding.
+const precision = 32
+
+// Loan defines the parameters of a loan.
+type Loan struct {
+	Principal    money.Value        // Principal is the amount that is borrowed. It must be positive and a multiple of the smallest unit of its currency.
+	Rate         money.Rate         // Rate is the nominal annual interest rate, e.g. 5%.
+	Installments int                // Installments is the term of the loan as the number of installments.
+	Frequency    Frequency          // Frequency is the number of installments per year.
+	Method       AmortizationMethod // Method defines how the loan is repaid.
+	Rounding     money.RoundingMode // Rounding is the rounding mode that is used to round all amounts to the smallest unit of the currency.
+}
+
+// Installment is a single payment of an amortization schedule.
+// Interest plus Principal is always exactly Payment.
+type Installment struct {
+	Number    int         // Number of the installment, starting with 1.
+	Payment   money.Value // Payment is the total amount that has to be paid.
+	Interest  money.Value // Interest is the part of the payment that covers the interest of the period.
+	Principal money.Value // Principal is the part of the payment that repays the loan.
+	Balance   money.Value // Balance is the remaining principal after the payment.
+}
+
+// periodicRate returns the interest rate per period.
+func (l Loan) periodicRate() decimal.Decimal {
+	return l.Rate.Ratio().DivRound(decimal.NewFromInt(int64(l.Frequency)), precision)
+}
+
+// Schedule returns the amortization schedule of the loan.
+//
+// The interest of every period is calculated from the remaining balance, and every installment is rounded to the smallest unit of the currency.
+// The rounding residue is folded into the final installment, so that the principal repayments sum exactly to the principal of the loan.
+func (l Loan) Schedule() ([]Installment, error) {
+	if l.Installments <= 0 {
+		return nil, fmt.Errorf("number of installments must be positive")
+	}
+	if l.Frequency <= 0 {
+		return nil, fmt.Errorf("frequency must be positive")
+	}
+	if l.Rate.Ratio().IsNegative() {
+		return nil, fmt.Errorf("interest rate %v is negative", l.Rate)
+	}
+	if !l.Principal.IsPositive() {
+		return nil, fmt.Errorf("principal %v must be positive", l.Principal)
+	}
+	if rounded, err := l.Principal.Round(l.Rounding); err != nil {
+		return nil, fmt.Errorf("failed to round principal: %w", err)
+	} else if !rounded.Equal(l.Principal) {
+		return nil, fmt.Errorf("principal %v is not a multiple of the smallest unit", l.Principal)
+	}
+
+	n := money.FromInt64(int64(l.Installments), nil)
+	frequency := money.FromInt64(int64(l.Frequency), nil)
+
+	// Get the regular payment (annuity) or principal repayment (linear).
+	var regular money.Value
+	var err error
+	switch l.Method {
+	case Annuity:
+		if l.Rate.IsZero() {
+			regular, err = l.Principal.DivRound(n, l.Rounding)
+			break
+		}
+		// payment = principal * r / (1 - (1+r)^-n).
+		r := l.periodicRate()
+		discount := decimal.NewFromInt(1).DivRound(powInt(decimal.NewFromInt(1).Add(r), l.Installments, precision), precision)
+		regular, err = l.Principal.MustMul(money.FromDecimal(r, nil)).DivRound(money.FromDecimal(decimal.NewFromInt(1).Sub(discount), nil), l.Rounding)
+	case Linear:
+		regular, err = l.Principal.DivRound(n, l.Rounding)
+	default:
+		return nil, fmt.Errorf("unknown amortization method %d", l.Method)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate regular installment: %w", err)
+	}
+
+	schedule := make([]Installment, l.Installments)
+	balance := l.Principal
+	for i := range schedule {
+		interest, err := balance.MustMul(l.Rate.Value()).DivRound(frequency, l.Rounding)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate interest of installment %d: %w", i+1, err)
+		}
+
+		var principal money.Value
+		switch {
+		case i == len(schedule)-1:
+			// Fold the rounding residue into the final installment.
+			principal = balance
+		case l.Method == Annuity:
+			principal = regular.MustSub(interest)
+		default:
+			principal = regular
+		}
+
+		// Don't repay more than what is left.
+		if principal.GreaterThan(balance) {
+			principal = balance
+		}
+
+		balance = balance.MustSub(principal)
+		schedule[i] = Installment{
+			Number:    i + 1,
+			Payment:   interest.MustAdd(principal),
+			Interest:  interest,
+			Principal: principal,
+			Balance:   balance,
+		}
+	}
+
+	return schedule, nil
+}
+
+// powInt returns x^n for a non-negative integer n.
+// Intermediate results are rounded to the given number of decimal places.
+func powInt(x decimal.Decimal, n int, places int32) decimal.Decimal {
+	result := decimal.NewFromInt(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = result.Mul(x).Round(places)
+		}
+		x = x.Mul(x).Round(places)
+	}
+
+	return result
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import (
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+)
+
+// checkSchedule checks if the installments of a schedule are consistent, and if the principal repayments sum exactly to the principal.
+func checkSchedule(t *testing.T, loan Loan, schedule []Installment) {
+	t.Helper()
+
+	if len(schedule) != loan.Installments {
+		t.Fatalf("Got %d installments, want %d", len(schedule), loan.Installments)
+	}
+
+	repaid := money.FromInt64(0, loan.Principal.Currency())
+	for i, installment := range schedule {
+		if installment.Number != i+1 {
+			t.Errorf("Installment %d has number %d", i+1, installment.Number)
+		}
+		if !installment.Interest.MustAdd(installment.Principal).Equal(installment.Payment) {
+			t.Errorf("Installment %d: Interest %v plus principal %v is not payment %v", i+1, installment.Interest, installment.Principal, installment.Payment)
+		}
+		repaid = repaid.MustAdd(installment.Principal)
+		if !loan.Principal.MustSub(repaid).Equal(installment.Balance) {
+			t.Errorf("Installment %d: Balance is %v, want %v", i+1, installment.Balance, loan.Principal.MustSub(repaid))
+		}
+		for _, v := range []money.Value{installment.Payment, installment.Interest, installment.Principal} {
+			if rounded, err := v.Round(money.RoundDown); err != nil || !rounded.Equal(v) {
+				t.Errorf("Installment %d: %v is not a multiple of the smallest unit", i+1, v)
+			}
+		}
+	}
+
+	if !repaid.Equal(loan.Principal) {
+		t.Errorf("Principal repayments sum to %v, want %v", repaid, loan.Principal)
+	}
+}
+
+func TestLoan_Schedule_Annuity(t *testing.T) {
+	loan := Loan{
+		Principal:    money.MustFromString("10000 ISO4217-EUR"),
+		Rate:         money.MustParseRate("5%"),
+		Installments: 12,
+		Frequency:    Monthly,
+	}
+
+	schedule, err := loan.Schedule()
+	if err != nil {
+		t.Fatalf("Loan.Schedule() failed: %v", err)
+	}
+
+	checkSchedule(t, loan, schedule)
+
+	for _, installment := range schedule[:len(schedule)-1] {
+		if !installment.Payment.Equal(money.MustFromString("856.07 ISO4217-EUR")) {
+			t.Errorf("Installment %d: Payment = %v, want 856.07 ISO4217-EUR", installment.Number, installment.Payment)
+		}
+	}
+	if first := schedule[0]; !first.Interest.Equal(money.MustFromString("41.67 ISO4217-EUR")) || !first.Principal.Equal(money.MustFromString("814.4 ISO4217-EUR")) {
+		t.Errorf("First installment = %+v, want interest 41.67 and principal 814.4", first)
+	}
+	if last := schedule[len(schedule)-1]; !last.Payment.Equal(money.MustFromString("856.12 ISO4217-EUR")) {
+		t.Errorf("Last installment: Payment = %v, want 856.12 ISO4217-EUR", last.Payment)
+	}
+}
+
+func TestLoan_Schedule_Linear(t *testing.T) {
+	loan := Loan{
+		Principal:    money.MustFromString("1000 ISO4217-EUR"),
+		Rate:         money.MustParseRate("6%"),
+		Installments: 3,
+		Frequency:    Annually,
+		Method:       Linear,
+	}
+
+	schedule, err := loan.Schedule()
+	if err != nil {
+		t.Fatalf("Loan.Schedule() failed: %v", err)
+	}
+
+	checkSchedule(t, loan, schedule)
+
+	want := []Installment{
+		{1, money.MustFromString("393.33 ISO4217-EUR"), money.MustFromString("60 ISO4217-EUR"), money.MustFromString("333.33 ISO4217-EUR"), money.MustFromString("666.67 ISO4217-EUR")},
+		{2, money.MustFromString("373.33 ISO4217-EUR"), money.MustFromString("40 ISO4217-EUR"), money.MustFromString("333.33 ISO4217-EUR"), money.MustFromString("333.34 ISO4217-EUR")},
+		{3, money.MustFromString("353.34 ISO4217-EUR"), money.MustFromString("20 ISO4217-EUR"), money.MustFromString("333.34 ISO4217-EUR"), money.MustFromString("0 ISO4217-EUR")},
+	}
+	for i, installment := range schedule {
+		w := want[i]
+		if !installment.Payment.Equal(w.Payment) || !installment.Interest.Equal(w.Interest) || !installment.Principal.Equal(w.Principal) || !installment.Balance.Equal(w.Balance) {
+			t.Errorf("Installment %d = %+v, want %+v", i+1, installment, w)
+		}
+	}
+}
+
+func TestLoan_Schedule_ZeroRate(t *testing.T) {
+	loan := Loan{
+		Principal:    money.MustFromString("100 ISO4217-EUR"),
+		Installments: 3,
+		Frequency:    Monthly,
+	}
+
+	schedule, err := loan.Schedule()
+	if err != nil {
+		t.Fatalf("Loan.Schedule() failed: %v", err)
+	}
+
+	checkSchedule(t, loan, schedule)
+
+	if !schedule[0].Payment.Equal(money.MustFromString("33.33 ISO4217-EUR")) || !schedule[2].Payment.Equal(money.MustFromString("33.34 ISO4217-EUR")) {
+		t.Errorf("Unexpected schedule %+v", schedule)
+	}
+}
+
+func TestLoan_Schedule_Long(t *testing.T) {
+	loan := Loan{
+		Principal:    money.MustFromString("250000 ISO4217-USD"),
+		Rate:         money.MustParseRate("6.5%"),
+		Installments: 360,
+		Frequency:    Monthly,
+	}
+
+	schedule, err := loan.Schedule()
+	if err != nil {
+		t.Fatalf("Loan.Schedule() failed: %v", err)
+	}
+
+	checkSchedule(t, loan, schedule)
+
+	if !schedule[0].Payment.Equal(money.MustFromString("1580.17 ISO4217-USD")) {
+		t.Errorf("Installment 1: Payment = %v, want 1580.17 ISO4217-USD", schedule[0].Payment)
+	}
+}
+
+func TestLoan_Schedule_Errors(t *testing.T) {
+	loans := []Loan{
+		{Principal: money.MustFromString("100 ISO4217-EUR"), Installments: 0, Frequency: Monthly},
+		{Principal: money.MustFromString("100 ISO4217-EUR"), Installments: 1, Frequency: 0},
+		{Principal: money.MustFromString("100 ISO4217-EUR"), Installments: 1, Frequency: Monthly, Rate: money.MustParseRate("-1%")},
+		{Principal: money.MustFromString("-100 ISO4217-EUR"), Installments: 1, Frequency: Monthly},
+		{Principal: money.MustFromString("100.001 ISO4217-EUR"), Installments: 1, Frequency: Monthly},
+		{Principal: money.MustFromString("100"), Installments: 1, Frequency: Monthly},
+		{Principal: money.MustFromString("100 ISO4217-EUR"), Installments: 1, Frequency: Monthly, Method: AmortizationMethod(-1)},
+	}
+
+	for i, loan := range loans {
+		if _, err := loan.Schedule(); err == nil {
+			t.Errorf("Loan %d: Loan.Schedule() did not fail", i)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DayCount defines a day count convention that determines how interest accrues over time.
+type DayCount int
+
+const (
+	Actual360         DayCount = iota // ACT/360: Actual number of days divided by 360. This is the default.
+	Actual365Fixed                    // ACT/365F: Actual number of days divided by 365.
+	Thirty360US                       // 30/360 US: Every month has 30 days, with the end of month rules for February and the 31st.
+	Thirty360European                 // 30E/360: Every month has 30 days, the 31st is always treated as the 30th.
+	ActualActualISDA                  // ACT/ACT ISDA: Days in leap years are divided by 366, all other days by 365.
+)
+
+func (dc DayCount) String() string {
+	switch dc {
+	case Actual360:
+		return "ACT/360"
+	case Actual365Fixed:
+		return "ACT/365F"
+	case Thirty360US:
+		return "30/360 US"
+	case Thirty360European:
+		return "30E/360"
+	case ActualActualISDA:
+		return "ACT/ACT ISDA"
+	}
+	return fmt.Sprintf("DayCount(%d)", int(dc))
+}
+
+// date returns the calendar date of t at midnight UTC.
+// The location of t is used to determine the calendar date.
+func date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// actualDays returns the number of calendar days between start and end.
+func actualDays(start, end time.Time) int64 {
+	return int64(date(end).Sub(date(start)).Hours()) / 24
+}
+
+// isLeapYear returns whether the given year has 366 days.
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// isLastDayOfFebruary returns whether t is the last day of February.
+func isLastDayOfFebruary(t time.Time) bool {
+	return t.Month() == time.February && t.AddDate(0, 0, 1).Month() == time.March
+}
+
+// thirty360 returns the number of days between start and end, where every month has 30 days.
+func thirty360(y1, m1, d1, y2, m2, d2 int) int64 {
+	return int64(360*(y2-y1) + 30*(m2-m1) + (d2 - d1))
+}
+
+// fraction returns the fraction of a year between start and end as numerator and denominator.
+// The denominator only depends on the day count convention. Use splitFraction to split an interval into fractions that sum exactly.
+func (dc DayCount) fraction(start, end time.Time) (num, den int64, err error) {
+	start, end = date(start), date(end)
+
+	switch dc {
+	case Actual360:
+		return actualDays(start, end), 360, nil
+
+	case Actual365Fixed:
+		return actualDays(start, end), 365, nil
+
+	case Thirty360US:
+		y1, m1, d1 := start.Date()
+		y2, m2, d2 := end.Date()
+		if isLastDayOfFebruary(start) && isLastDayOfFebruary(end) {
+			d2 = 30
+		}
+		if isLastDayOfFebruary(start) {
+			d1 = 30
+		}
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+		if d1 == 31 {
+			d1 = 30
+		}
+		return thirty360(y1, int(m1), d1, y2, int(m2), d2), 360, nil
+
+	case Thirty360European:
+		y1, m1, d1 := start.Date()
+		y2, m2, d2 := end.Date()
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 {
+			d2 = 30
+		}
+		return thirty360(y1, int(m1), d1, y2, int(m2), d2), 360, nil
+
+	case ActualActualISDA:
+		// Split the interval at year boundaries, and weight the days with 366 or 365.
+		// The common denominator is 365*366.
+		sign := int64(1)
+		if end.Before(start) {
+			start, end, sign = end, start, -1
+		}
+		for t := start; t.Before(end); {
+			next := time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+			if next.After(end) {
+				next = end
+			}
+			if isLeapYear(t.Year()) {
+				num += actualDays(t, next) * 365
+			} else {
+				num += actualDays(t, next) * 366
+			}
+			t = next
+		}
+		return sign * num, 365 * 366, nil
+	}
+
+	return 0, 0, fmt.Errorf("unknown day count convention %d", dc)
+}
+
+// splitFraction returns the fractions of a year between consecutive points as numerators with a common denominator.
+// The points must be sorted, and the numerators always sum to the numerator of the fraction between the first and the last point.
+//
+// This is not the case when fractions are calculated for every pair of points on its own, as the end of month rules of 30/360 US depend on the start date.
+// Therefore the day count of 30/360 US is calculated once for the whole interval, and split at the intermediate points, which are mapped like with 30E/360.
+func (dc DayCount) splitFraction(points []time.Time) (nums []int64, den int64, err error) {
+	nums = make([]int64, len(points)-1)
+	if dc != Thirty360US {
+		for i := range nums {
+			if nums[i], den, err = dc.fraction(points[i], points[i+1]); err != nil {
+				return nil, 0, err
+			}
+		}
+		return nums, den, nil
+	}
+
+	first, last := date(points[0]), date(points[len(points)-1])
+	total, den, err := dc.fraction(first, last)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Same start date adjustment as in fraction.
+	y1, m1, d1 := first.Date()
+	if isLastDayOfFebruary(first) || d1 == 31 {
+		d1 = 30
+	}
+
+	// serials contains the day count from the first point to every point.
+	serials := make([]int64, len(points))
+	serials[len(points)-1] = total
+	for i := 1; i < len(points)-1; i++ {
+		y, m, d := date(points[i]).Date()
+		if d == 31 {
+			d = 30
+		}
+		serial := thirty360(y1, int(m1), d1, y, int(m), d)
+		if serial < 0 {
+			serial = 0
+		}
+		if serial > total {
+			serial = total
+		}
+		serials[i] = serial
+	}
+
+	for i := range nums {
+		nums[i] = serials[i+1] - serials[i]
+	}
+
+	return nums, den, nil
+}
+
+// Days returns the number of days between start and end according to the day count convention.
+// For ACT/ACT ISDA this returns the actual number of days.
+func (dc DayCount) Days(start, end time.Time) (int64, error) {
+	switch dc {
+	case ActualActualISDA:
+		return actualDays(start, end), nil
+	}
+
+	num, _, err := dc.fraction(start, end)
+	return num, err
+}
+
+// YearFraction returns the fraction of a year between start and end according to the day count convention.
+// The result is rounded to 32 decimal places, if it can't be represented exactly.
+func (dc DayCount) YearFraction(start, end time.Time) (decimal.Decimal, error) {
+	num, den, err := dc.fraction(start, end)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return decimal.NewFromInt(num).DivRound(decimal.NewFromInt(den), precision), nil
+}
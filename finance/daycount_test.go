@@ -0,0 +1,100 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDayCount_YearFraction(t *testing.T) {
+	d := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	// Expected fraction as numerator and denominator.
+	type fraction struct{ num, den int64 }
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       map[DayCount]fraction
+	}{
+		{"1", d(2007, 12, 28), d(2008, 2, 28), map[DayCount]fraction{
+			Actual360:         {62, 360},
+			Actual365Fixed:    {62, 365},
+			Thirty360US:       {60, 360},
+			Thirty360European: {60, 360},
+			ActualActualISDA:  {4*366 + 58*365, 365 * 366},
+		}},
+		{"2", d(2007, 10, 31), d(2008, 11, 30), map[DayCount]fraction{
+			Actual360:         {396, 360},
+			Thirty360US:       {390, 360},
+			Thirty360European: {390, 360},
+			ActualActualISDA:  {62*366 + 334*365, 365 * 366},
+		}},
+		{"3", d(2008, 2, 29), d(2009, 2, 28), map[DayCount]fraction{
+			Actual365Fixed:    {365, 365},
+			Thirty360US:       {360, 360},
+			Thirty360European: {359, 360},
+			ActualActualISDA:  {307*365 + 58*366, 365 * 366},
+		}},
+		{"4", d(2007, 1, 31), d(2007, 3, 31), map[DayCount]fraction{
+			Thirty360US:       {60, 360},
+			Thirty360European: {60, 360},
+		}},
+		{"5", d(2007, 1, 15), d(2007, 3, 31), map[DayCount]fraction{
+			Thirty360US:       {76, 360},
+			Thirty360European: {75, 360},
+		}},
+		{"6", d(2008, 1, 1), d(2008, 12, 31), map[DayCount]fraction{
+			ActualActualISDA: {365 * 365, 365 * 366},
+		}},
+		{"7", d(2008, 3, 1), d(2008, 1, 1), map[DayCount]fraction{
+			Actual360:        {-60, 360},
+			ActualActualISDA: {-60 * 365, 365 * 366},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for dc, want := range tt.want {
+				got, err := dc.YearFraction(tt.start, tt.end)
+				if err != nil {
+					t.Errorf("%v.YearFraction() failed: %v", dc, err)
+					continue
+				}
+				if wantDecimal := decimal.NewFromInt(want.num).DivRound(decimal.NewFromInt(want.den), precision); !got.Equal(wantDecimal) {
+					t.Errorf("%v.YearFraction() = %v, want %v", dc, got, wantDecimal)
+				}
+			}
+		})
+	}
+
+	if _, err := DayCount(-1).YearFraction(d(2008, 1, 1), d(2008, 2, 1)); err == nil {
+		t.Errorf("YearFraction() with unknown day count convention did not fail")
+	}
+}
+
+func TestDayCount_Days(t *testing.T) {
+	start, end := time.Date(2007, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2007, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := map[DayCount]int64{
+		Actual360:         75,
+		Actual365Fixed:    75,
+		Thirty360US:       76,
+		Thirty360European: 75,
+		ActualActualISDA:  75,
+	}
+	for dc, want := range tests {
+		if got, err := dc.Days(start, end); err != nil {
+			t.Errorf("%v.Days() failed: %v", dc, err)
+		} else if got != want {
+			t.Errorf("%v.Days() = %v, want %v", dc, got, want)
+		}
+	}
+}
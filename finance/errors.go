@@ -0,0 +1,27 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import "fmt"
+
+// ErrorNoConvergence is returned when an iterative solver doesn't find a solution within the allowed number of iterations.
+type ErrorNoConvergence struct {
+	iterations int
+	reason     string
+}
+
+func (e *ErrorNoConvergence) Error() string {
+	if e.reason != "" {
+		return fmt.Sprintf("solver didn't converge after %d iterations: %s", e.iterations, e.reason)
+	}
+	return fmt.Sprintf("solver didn't converge after %d iterations", e.iterations)
+}
+
+// Iterations returns the number of iterations the solver ran before it gave up.
+func (e *ErrorNoConvergence) Iterations() int { return e.iterations }
+
+// Reason returns why the solver stopped early, or an empty string if it ran out of iterations.
+func (e *ErrorNoConvergence) Reason() string { return e.reason }
@@ -0,0 +1,187 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import (
+	"fmt"
+	"time"
+
+	money "github.com/Dadido3/D3money"
+)
+
+// RemainderPlacement defines which installments receive the remainder if a value can't be split evenly.
+type RemainderPlacement int
+
+const (
+	RemainderRoundRobin RemainderPlacement = iota // The remaining smallest units are distributed one by one, starting with the first installment. This is the default.
+	RemainderFirst                                // The whole remainder is added to the first installment.
+	RemainderLast                                 // The whole remainder is added to the last installment.
+)
+
+// InstallmentPlan defines how a value is split into installments that are due on a date schedule.
+type InstallmentPlan struct {
+	Total        money.Value        // Total is the value that is split into installments. It must be a multiple of the smallest unit of its currency.
+	Installments int                // Installments is the number of installments. It is ignored if Dates is set.
+	Start        time.Time          // Start is the due date of the first installment. It is ignored if Dates is set.
+	Frequency    Frequency          // Frequency is the number of installments per year. It is ignored if Dates is set.
+	Dates        []time.Time        // Dates contains custom due dates. If set, there is one installment per date.
+	Remainder    RemainderPlacement // Remainder defines which installments receive the remainder.
+	Minimum      money.Value        // Minimum is the smallest allowed absolute amount of an installment. If set, the number of installments is reduced until all installments reach it. Custom dates are never dropped, a plan with Dates fails if not all installments reach the minimum.
+}
+
+// PlannedInstallment is a single installment of an installment plan.
+type PlannedInstallment struct {
+	Number int         // Number of the installment, starting with 1.
+	Date   time.Time   // Date when the installment is due.
+	Amount money.Value // Amount of the installment.
+}
+
+// addMonths returns t plus the given number of months.
+// If the resulting month has less days than the day of t, the last day of the month is used.
+// E.g. 31 January plus one month is 28 or 29 February.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	// The day 0 of the following month is the last day of the target month.
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month+time.Month(months), day, hour, minute, second, t.Nanosecond(), t.Location())
+}
+
+// dates returns the due dates of all n installments.
+func (p InstallmentPlan) dates(n int) ([]time.Time, error) {
+	if len(p.Dates) > 0 {
+		for i := 1; i < len(p.Dates); i++ {
+			if !date(p.Dates[i]).After(date(p.Dates[i-1])) {
+				return nil, fmt.Errorf("date %d (%v) is not after the previous one", i, p.Dates[i])
+			}
+		}
+		if n != len(p.Dates) {
+			return nil, fmt.Errorf("the total can't be split into %d installments that reach the minimum", len(p.Dates))
+		}
+		return p.Dates, nil
+	}
+
+	var months, days int
+	switch p.Frequency {
+	case Annually, SemiAnnually, Quarterly, Monthly:
+		months = 12 / int(p.Frequency)
+	case BiWeekly:
+		days = 14
+	case Weekly:
+		days = 7
+	default:
+		return nil, fmt.Errorf("unsupported frequency %d", p.Frequency)
+	}
+
+	dates := make([]time.Time, n)
+	for i := range dates {
+		// Always start from the first date, so that days at the end of a month don't drift.
+		dates[i] = addMonths(p.Start, i*months).AddDate(0, 0, i*days)
+	}
+
+	return dates, nil
+}
+
+// split returns the total split into n parts.
+func (p InstallmentPlan) split(n int) ([]money.Value, error) {
+	parts, err := p.Total.Split(n)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.Remainder {
+	case RemainderRoundRobin:
+		return parts, nil
+	case RemainderFirst, RemainderLast:
+	default:
+		return nil, fmt.Errorf("unknown remainder placement %d", p.Remainder)
+	}
+
+	// The last part of a round-robin split never contains any remainder.
+	regular := parts[n-1]
+	rest := p.Total.MustSub(regular.MustMul(money.FromInt64(int64(n-1), nil)))
+	for i := range parts {
+		parts[i] = regular
+	}
+	if p.Remainder == RemainderFirst {
+		parts[0] = rest
+	} else {
+		parts[n-1] = rest
+	}
+
+	return parts, nil
+}
+
+// Schedule returns the installments of the plan.
+// The amounts of all installments sum exactly to the total.
+//
+// If a minimum is set, and the total can't be split into the requested number of installments that all reach the minimum, less installments are used.
+// In case the total itself is smaller than the minimum, there is only a single installment.
+// With custom dates, the number of installments is fixed, and an error is returned instead.
+func (p InstallmentPlan) Schedule() ([]PlannedInstallment, error) {
+	n := p.Installments
+	if len(p.Dates) > 0 {
+		n = len(p.Dates)
+	}
+	if n <= 0 {
+		return nil, fmt.Errorf("number of installments must be positive")
+	}
+
+	hasMinimum := !p.Minimum.IsZero()
+	if hasMinimum {
+		if _, err := p.Minimum.Cmp(p.Total); err != nil {
+			return nil, fmt.Errorf("minimum: %w", err)
+		}
+		if p.Minimum.IsNegative() {
+			return nil, fmt.Errorf("minimum %v is negative", p.Minimum)
+		}
+	}
+
+	var parts []money.Value
+	for ; n > 0; n-- {
+		var err error
+		if parts, err = p.split(n); err != nil {
+			return nil, fmt.Errorf("failed to split total: %w", err)
+		}
+		if !hasMinimum || n == 1 || smallestAbs(parts).GreaterThanOrEqual(p.Minimum) {
+			break
+		}
+	}
+
+	dates, err := p.dates(n)
+	if err != nil {
+		return nil, err
+	}
+
+	installments := make([]PlannedInstallment, n)
+	for i := range installments {
+		installments[i] = PlannedInstallment{
+			Number: i + 1,
+			Date:   dates[i],
+			Amount: parts[i],
+		}
+	}
+
+	return installments, nil
+}
+
+// smallestAbs returns the smallest absolute amount of the given values.
+// All values must have the same currency.
+func smallestAbs(values []money.Value) money.Value {
+	smallest := values[0].Abs()
+	for _, value := range values[1:] {
+		if value.Abs().LessThan(smallest) {
+			smallest = value.Abs()
+		}
+	}
+
+	return smallest
+}
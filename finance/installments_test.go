@@ -0,0 +1,90 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import (
+	"testing"
+	"time"
+
+	money "github.com/Dadido3/D3money"
+)
+
+func TestInstallmentPlan_Schedule(t *testing.T) {
+	day := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		plan        InstallmentPlan
+		wantDates   []time.Time
+		wantAmounts []string
+		wantErr     bool
+	}{
+		{"1", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Installments: 3, Start: day(2024, 1, 31), Frequency: Monthly},
+			[]time.Time{day(2024, 1, 31), day(2024, 2, 29), day(2024, 3, 31)},
+			[]string{"33.34", "33.33", "33.33"}, false},
+		{"2", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Installments: 3, Start: day(2024, 1, 31), Frequency: Monthly, Remainder: RemainderLast},
+			[]time.Time{day(2024, 1, 31), day(2024, 2, 29), day(2024, 3, 31)},
+			[]string{"33.33", "33.33", "33.34"}, false},
+		{"3", InstallmentPlan{Total: money.MustFromString("100.05 ISO4217-EUR"), Installments: 7, Start: day(2024, 1, 1), Frequency: Weekly, Remainder: RemainderFirst},
+			[]time.Time{day(2024, 1, 1), day(2024, 1, 8), day(2024, 1, 15), day(2024, 1, 22), day(2024, 1, 29), day(2024, 2, 5), day(2024, 2, 12)},
+			[]string{"14.31", "14.29", "14.29", "14.29", "14.29", "14.29", "14.29"}, false},
+		{"4", InstallmentPlan{Total: money.MustFromString("-100 ISO4217-EUR"), Installments: 2, Start: day(2024, 1, 1), Frequency: BiWeekly, Remainder: RemainderLast},
+			[]time.Time{day(2024, 1, 1), day(2024, 1, 15)},
+			[]string{"-50", "-50"}, false},
+		{"5", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Dates: []time.Time{day(2024, 5, 1), day(2024, 7, 12)}},
+			[]time.Time{day(2024, 5, 1), day(2024, 7, 12)},
+			[]string{"50", "50"}, false},
+		{"6", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Installments: 12, Start: day(2024, 1, 1), Frequency: Quarterly, Minimum: money.MustFromString("30 ISO4217-EUR")},
+			[]time.Time{day(2024, 1, 1), day(2024, 4, 1), day(2024, 7, 1)},
+			[]string{"33.34", "33.33", "33.33"}, false},
+		{"7", InstallmentPlan{Total: money.MustFromString("10 ISO4217-EUR"), Installments: 12, Start: day(2024, 1, 1), Frequency: Monthly, Minimum: money.MustFromString("30 ISO4217-EUR")},
+			[]time.Time{day(2024, 1, 1)},
+			[]string{"10"}, false},
+		{"8", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Dates: []time.Time{day(2024, 5, 1), day(2024, 7, 12)}, Minimum: money.MustFromString("50 ISO4217-EUR")},
+			[]time.Time{day(2024, 5, 1), day(2024, 7, 12)},
+			[]string{"50", "50"}, false},
+		{"9", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Installments: 2, Start: day(2024, 1, 1), Frequency: Monthly, Minimum: money.MustFromString("50")}, nil, nil, true},
+		{"10", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Dates: []time.Time{day(2024, 5, 1), day(2024, 5, 1)}}, nil, nil, true},
+		{"11", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Installments: 2, Start: day(2024, 1, 1), Frequency: 3}, nil, nil, true},
+		{"12", InstallmentPlan{Total: money.MustFromString("100.001 ISO4217-EUR"), Installments: 2, Start: day(2024, 1, 1), Frequency: Monthly}, nil, nil, true},
+		{"13", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Start: day(2024, 1, 1), Frequency: Monthly}, nil, nil, true},
+		{"14", InstallmentPlan{Total: money.MustFromString("100 ISO4217-EUR"), Dates: []time.Time{day(2024, 5, 1), day(2024, 7, 12), day(2024, 8, 1)}, Minimum: money.MustFromString("50 ISO4217-EUR")}, nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.plan.Schedule()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InstallmentPlan.Schedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if len(got) != len(tt.wantAmounts) {
+				t.Fatalf("Got %d installments, want %d", len(got), len(tt.wantAmounts))
+			}
+
+			sum := money.FromInt64(0, tt.plan.Total.Currency())
+			for i, installment := range got {
+				if installment.Number != i+1 {
+					t.Errorf("Installment %d has number %d", i+1, installment.Number)
+				}
+				if !installment.Date.Equal(tt.wantDates[i]) {
+					t.Errorf("Installment %d: Date is %v, want %v", i+1, installment.Date, tt.wantDates[i])
+				}
+				if want := money.MustFromStringAndCurrency(tt.wantAmounts[i], tt.plan.Total.Currency()); !installment.Amount.Equal(want) {
+					t.Errorf("Installment %d: Amount is %v, want %v", i+1, installment.Amount, want)
+				}
+				sum = sum.MustAdd(installment.Amount)
+			}
+			if !sum.Equal(tt.plan.Total) {
+				t.Errorf("Installments sum to %v, want %v", sum, tt.plan.Total)
+			}
+		})
+	}
+}
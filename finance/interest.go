@@ -0,0 +1,144 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	money "github.com/Dadido3/D3money"
+)
+
+// BalanceChange is a dated change of a balance, like a deposit or a withdrawal.
+// The change takes effect at the start of the given day.
+type BalanceChange struct {
+	Date   time.Time   // Date of the change. Only the calendar date is used.
+	Amount money.Value // Amount that is added to the balance. Withdrawals are negative.
+}
+
+// RateChange is a dated change of an interest rate.
+// The rate is effective from the start of the given day until the next rate change.
+type RateChange struct {
+	Date time.Time  // Date from which on the rate is effective. Only the calendar date is used.
+	Rate money.Rate // Rate is the nominal annual interest rate, e.g. 2.5%.
+}
+
+// Accrual contains the interest that accrued in a single period.
+type Accrual struct {
+	Start    time.Time   // Start of the period, inclusive.
+	End      time.Time   // End of the period, exclusive.
+	Balance  money.Value // Balance at the end of the period, without any interest.
+	Interest money.Value // Interest that accrued in the period, rounded to the smallest unit of the currency.
+}
+
+// InterestCalculator calculates the interest that accrues on a balance.
+type InterestCalculator struct {
+	DayCount DayCount           // DayCount is the day count convention that is used to determine the fraction of a year.
+	Rates    []RateChange       // Rates contains all changes of the interest rate. Before the first rate change the rate is 0%.
+	Rounding money.RoundingMode // Rounding is the rounding mode that is used to round the interest of every period.
+}
+
+// Accrue returns the simple interest that accrues on a balance in every period.
+// The balance is the sum of all balance changes up to a given day, and the periods are defined by the boundaries between them.
+// With the boundaries p0, p1 and p2 the periods are [p0, p1) and [p1, p2).
+//
+// The interest of a period is calculated exactly, and rounded once to the smallest unit of the currency.
+// Interest is not added to the balance, so there is no compounding.
+func (c InterestCalculator) Accrue(changes []BalanceChange, boundaries []time.Time) ([]Accrual, error) {
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("list of balance changes is empty")
+	}
+	if len(boundaries) < 2 {
+		return nil, fmt.Errorf("at least two period boundaries are needed")
+	}
+	for i := 1; i < len(boundaries); i++ {
+		if !date(boundaries[i]).After(date(boundaries[i-1])) {
+			return nil, fmt.Errorf("period boundary %d (%v) is not after the previous one", i, boundaries[i])
+		}
+	}
+
+	// Sort copies of the changes by their date.
+	changes = append([]BalanceChange(nil), changes...)
+	sort.SliceStable(changes, func(i, j int) bool { return date(changes[i].Date).Before(date(changes[j].Date)) })
+	rates := append([]RateChange(nil), c.Rates...)
+	sort.SliceStable(rates, func(i, j int) bool { return date(rates[i].Date).Before(date(rates[j].Date)) })
+
+	cur := changes[0].Amount.Currency()
+	for i, change := range changes {
+		if _, err := change.Amount.Cmp(changes[0].Amount); err != nil {
+			return nil, fmt.Errorf("balance change %d: %w", i, err)
+		}
+	}
+
+	accruals := make([]Accrual, len(boundaries)-1)
+	for i := range accruals {
+		start, end := date(boundaries[i]), date(boundaries[i+1])
+
+		// Get all days at which the balance or the rate changes.
+		points := []time.Time{start, end}
+		for _, change := range changes {
+			if d := date(change.Date); d.After(start) && d.Before(end) {
+				points = append(points, d)
+			}
+		}
+		for _, rate := range rates {
+			if d := date(rate.Date); d.After(start) && d.Before(end) {
+				points = append(points, d)
+			}
+		}
+		sort.Slice(points, func(i, j int) bool { return points[i].Before(points[j]) })
+
+		// Sum the interest of all sub periods with constant balance and rate.
+		// The fractions of the sub periods have a common denominator, and their numerators sum to the one of the whole period.
+		nums, den, err := c.DayCount.splitFraction(points)
+		if err != nil {
+			return nil, err
+		}
+		sum := money.FromInt64(0, cur)
+		for j, num := range nums {
+			a := points[j]
+			sum = sum.MustAdd(balanceAt(changes, cur, a).MustMul(rateAt(rates, a).Value()).MustMul(money.FromInt64(num, nil)))
+		}
+
+		interest, err := sum.DivRound(money.FromInt64(den, nil), c.Rounding)
+		if err != nil {
+			return nil, fmt.Errorf("failed to round interest of period %d: %w", i, err)
+		}
+
+		accruals[i] = Accrual{Start: start, End: end, Balance: balanceAt(changes, cur, end.AddDate(0, 0, -1)), Interest: interest}
+	}
+
+	return accruals, nil
+}
+
+// balanceAt returns the sum of all changes up to and including the given day.
+// The changes must be sorted by their date.
+func balanceAt(changes []BalanceChange, cur money.Currency, day time.Time) money.Value {
+	balance := money.FromInt64(0, cur)
+	for _, change := range changes {
+		if date(change.Date).After(day) {
+			break
+		}
+		balance = balance.MustAdd(change.Amount)
+	}
+
+	return balance
+}
+
+// rateAt returns the rate that is effective at the given day.
+// The rate changes must be sorted by their date.
+func rateAt(rates []RateChange, day time.Time) money.Rate {
+	var rate money.Rate
+	for _, change := range rates {
+		if date(change.Date).After(day) {
+			break
+		}
+		rate = change.Rate
+	}
+
+	return rate
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import (
+	"testing"
+	"time"
+
+	money "github.com/Dadido3/D3money"
+)
+
+func TestInterestCalculator_Accrue(t *testing.T) {
+	d := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	changes := []BalanceChange{
+		{d(2024, 1, 16), money.MustFromString("500 ISO4217-EUR")},
+		{d(2024, 1, 1), money.MustFromString("1000 ISO4217-EUR")},
+		{d(2024, 3, 1), money.MustFromString("-1500 ISO4217-EUR")},
+	}
+	rates := []RateChange{
+		{d(2024, 2, 1), money.MustParseRate("7.2%")},
+		{d(2023, 12, 1), money.MustParseRate("3.6%")},
+	}
+	boundaries := []time.Time{d(2024, 1, 1), d(2024, 2, 1), d(2024, 3, 1), d(2024, 4, 1)}
+
+	tests := []struct {
+		name          string
+		dayCount      DayCount
+		wantInterests []string
+	}{
+		{"ACT/360", Actual360, []string{"3.9", "8.7", "0"}},
+		{"ACT/365F", Actual365Fixed, []string{"3.85", "8.58", "0"}},
+		{"30/360 US", Thirty360US, []string{"3.75", "9", "0"}},
+		{"30E/360", Thirty360European, []string{"3.75", "9", "0"}},
+		{"ACT/ACT ISDA", ActualActualISDA, []string{"3.84", "8.56", "0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := InterestCalculator{DayCount: tt.dayCount, Rates: rates}
+			got, err := c.Accrue(changes, boundaries)
+			if err != nil {
+				t.Fatalf("InterestCalculator.Accrue() failed: %v", err)
+			}
+
+			if len(got) != len(tt.wantInterests) {
+				t.Fatalf("Got %d accruals, want %d", len(got), len(tt.wantInterests))
+			}
+			for i, accrual := range got {
+				if want := money.MustFromStringAndCurrency(tt.wantInterests[i], money.ISO4217Currencies.ByCode("EUR")); !accrual.Interest.Equal(want) {
+					t.Errorf("Accrual %d: Interest = %v, want %v", i, accrual.Interest, want)
+				}
+				if !accrual.Start.Equal(boundaries[i]) || !accrual.End.Equal(boundaries[i+1]) {
+					t.Errorf("Accrual %d: Period is [%v, %v), want [%v, %v)", i, accrual.Start, accrual.End, boundaries[i], boundaries[i+1])
+				}
+			}
+			if !got[1].Balance.Equal(money.MustFromString("1500 ISO4217-EUR")) || !got[2].Balance.Equal(money.MustFromString("0 ISO4217-EUR")) {
+				t.Errorf("Unexpected balances %v and %v", got[1].Balance, got[2].Balance)
+			}
+		})
+	}
+}
+
+func TestInterestCalculator_Accrue_ZeroChange(t *testing.T) {
+	d := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		boundaries []time.Time
+		zeroDates  []time.Time
+	}{
+		{"1", []time.Time{d(2026, 1, 15), d(2026, 3, 15)}, []time.Time{d(2026, 1, 31), d(2026, 2, 28), d(2026, 3, 1)}},
+		{"2", []time.Time{d(2028, 1, 15), d(2028, 3, 15)}, []time.Time{d(2028, 1, 31), d(2028, 2, 28), d(2028, 2, 29)}},
+		{"3", []time.Time{d(2026, 1, 31), d(2026, 3, 31)}, []time.Time{d(2026, 2, 28), d(2026, 3, 30)}},
+		{"4", []time.Time{d(2026, 2, 28), d(2026, 5, 31)}, []time.Time{d(2026, 3, 31), d(2026, 4, 30)}},
+	}
+	for _, dayCount := range []DayCount{Actual360, Actual365Fixed, Thirty360US, Thirty360European, ActualActualISDA} {
+		for _, tt := range tests {
+			t.Run(dayCount.String()+"_"+tt.name, func(t *testing.T) {
+				c := InterestCalculator{DayCount: dayCount, Rates: []RateChange{{d(2020, 1, 1), money.MustParseRate("12%")}}}
+				changes := []BalanceChange{{d(2020, 1, 1), money.MustFromString("36000 ISO4217-EUR")}}
+
+				want, err := c.Accrue(changes, tt.boundaries)
+				if err != nil {
+					t.Fatalf("InterestCalculator.Accrue() failed: %v", err)
+				}
+
+				// A change of zero must not alter the interest, no matter when it happens.
+				for _, zeroDate := range tt.zeroDates {
+					got, err := c.Accrue(append(changes, BalanceChange{zeroDate, money.MustFromString("0 ISO4217-EUR")}), tt.boundaries)
+					if err != nil {
+						t.Fatalf("InterestCalculator.Accrue() failed: %v", err)
+					}
+					if !got[0].Interest.Equal(want[0].Interest) {
+						t.Errorf("Zero change at %v: Interest = %v, want %v", zeroDate, got[0].Interest, want[0].Interest)
+					}
+				}
+			})
+		}
+	}
+
+	// The example of 36000 EUR at 12% over two months of 30 days.
+	c := InterestCalculator{DayCount: Thirty360US, Rates: []RateChange{{d(2020, 1, 1), money.MustParseRate("12%")}}}
+	got, err := c.Accrue([]BalanceChange{{d(2020, 1, 1), money.MustFromString("36000 ISO4217-EUR")}, {d(2026, 1, 31), money.MustFromString("0 ISO4217-EUR")}}, []time.Time{d(2026, 1, 15), d(2026, 3, 15)})
+	if err != nil {
+		t.Fatalf("InterestCalculator.Accrue() failed: %v", err)
+	}
+	if want := money.MustFromString("720 ISO4217-EUR"); !got[0].Interest.Equal(want) {
+		t.Errorf("Interest = %v, want %v", got[0].Interest, want)
+	}
+}
+
+func TestInterestCalculator_Accrue_Errors(t *testing.T) {
+	d := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	c := InterestCalculator{Rates: []RateChange{{d(2024, 1, 1), money.MustParseRate("1%")}}}
+
+	if _, err := c.Accrue(nil, []time.Time{d(2024, 1, 1), d(2024, 2, 1)}); err == nil {
+		t.Errorf("Accrue() without balance changes did not fail")
+	}
+	if _, err := c.Accrue([]BalanceChange{{d(2024, 1, 1), money.MustFromString("1 ISO4217-EUR")}}, []time.Time{d(2024, 1, 1)}); err == nil {
+		t.Errorf("Accrue() with a single boundary did not fail")
+	}
+	if _, err := c.Accrue([]BalanceChange{{d(2024, 1, 1), money.MustFromString("1 ISO4217-EUR")}}, []time.Time{d(2024, 2, 1), d(2024, 1, 1)}); err == nil {
+		t.Errorf("Accrue() with unsorted boundaries did not fail")
+	}
+	if _, err := c.Accrue([]BalanceChange{{d(2024, 1, 1), money.MustFromString("1 ISO4217-EUR")}, {d(2024, 1, 1), money.MustFromString("1 ISO4217-USD")}}, []time.Time{d(2024, 1, 1), d(2024, 2, 1)}); err == nil {
+		t.Errorf("Accrue() with mixed currencies did not fail")
+	}
+	if _, err := c.Accrue([]BalanceChange{{d(2024, 1, 1), money.MustFromString("1")}}, []time.Time{d(2024, 1, 1), d(2024, 2, 1)}); err == nil {
+		t.Errorf("Accrue() without smallest unit did not fail")
+	}
+}
@@ -0,0 +1,390 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import (
+	"fmt"
+	"time"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/shopspring/decimal"
+)
+
+// CashFlow is a dated payment.
+// Incoming payments are positive, outgoing payments are negative.
+type CashFlow struct {
+	Date   time.Time   // Date of the payment. Only the calendar date is used.
+	Amount money.Value // Amount of the payment.
+}
+
+// PaymentTiming defines when payments are made within a period.
+type PaymentTiming int
+
+const (
+	EndOfPeriod       PaymentTiming = iota // Payments are made at the end of every period (ordinary annuity). This is the default.
+	BeginningOfPeriod                      // Payments are made at the beginning of every period (annuity due).
+)
+
+// Default parameters of the Solver.
+const (
+	defaultMaxIterations = 100
+	defaultToleranceExp  = -12 // The default tolerance is 10^defaultToleranceExp.
+)
+
+// Solver contains the parameters of the iterative solvers that are used to calculate internal rates of return.
+// The zero value is valid and uses the default parameters.
+type Solver struct {
+	Precision     int32           // Precision is the number of decimal places of intermediate results and of the result. Defaults to 32.
+	Tolerance     decimal.Decimal // The solver stops once the rate changes by less than the tolerance. Defaults to 10^-12.
+	MaxIterations int             // MaxIterations is the maximum number of iterations before ErrorNoConvergence is returned. Defaults to 100.
+	Guess         money.Rate      // Guess is the rate where the solver starts. Defaults to 0%.
+}
+
+func (s Solver) precision() int32 {
+	if s.Precision <= 0 {
+		return precision
+	}
+	return s.Precision
+}
+
+func (s Solver) tolerance() decimal.Decimal {
+	if !s.Tolerance.IsPositive() {
+		return decimal.New(1, defaultToleranceExp)
+	}
+	return s.Tolerance
+}
+
+func (s Solver) maxIterations() int {
+	if s.MaxIterations <= 0 {
+		return defaultMaxIterations
+	}
+	return s.MaxIterations
+}
+
+// checkAmounts returns the common currency of the given values.
+// It returns an error if values is empty, or if the currencies of the values differ.
+func checkAmounts(values []money.Value) (money.Currency, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("list of values is empty")
+	}
+
+	for i, value := range values {
+		if _, err := value.Cmp(values[0]); err != nil {
+			return nil, fmt.Errorf("value %d: %w", i, err)
+		}
+	}
+
+	return values[0].Currency(), nil
+}
+
+// checkSigns returns an error if values doesn't contain at least one positive and one negative amount.
+// Without a sign change there is no internal rate of return.
+func checkSigns(values []money.Value) error {
+	var positive, negative bool
+	for _, value := range values {
+		positive = positive || value.IsPositive()
+		negative = negative || value.IsNegative()
+	}
+	if !positive || !negative {
+		return fmt.Errorf("values must contain at least one positive and one negative amount")
+	}
+
+	return nil
+}
+
+// checkRate returns an error if the rate is not larger than -100%.
+func checkRate(rate decimal.Decimal) error {
+	if rate.LessThanOrEqual(decimal.NewFromInt(-1)) {
+		return fmt.Errorf("rate %v must be larger than -100%%", money.NewRate(rate))
+	}
+
+	return nil
+}
+
+// flowTimes returns the currency and the amounts of the given cash flows, and their times in years since the date of the first cash flow.
+// A year has 365 days.
+func flowTimes(flows []CashFlow) (money.Currency, []money.Value, []decimal.Decimal, error) {
+	amounts := make([]money.Value, len(flows))
+	times := make([]decimal.Decimal, len(flows))
+	for i, flow := range flows {
+		days := actualDays(date(flows[0].Date), date(flow.Date))
+		if days < 0 {
+			return nil, nil, nil, fmt.Errorf("cash flow %d (%v) is before the first cash flow", i, flow.Date)
+		}
+		amounts[i], times[i] = flow.Amount, decimal.New(days, 0).DivRound(decimal.NewFromInt(365), precision)
+	}
+
+	cur, err := checkAmounts(amounts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return cur, amounts, times, nil
+}
+
+// discount returns the sum of the amounts discounted by (1+rate)^-t, and its derivative with respect to the rate.
+func discount(rate decimal.Decimal, amounts []money.Value, times []decimal.Decimal, places int32) (sum, derivative decimal.Decimal, err error) {
+	base := decimal.NewFromInt(1).Add(rate)
+
+	for i, amount := range amounts {
+		t := times[i]
+
+		var factor decimal.Decimal
+		if t.Equal(t.Truncate(0)) {
+			factor = decimal.NewFromInt(1).DivRound(powInt(base, int(t.IntPart()), places), places)
+		} else if factor, err = base.PowWithPrecision(t.Neg(), places); err != nil {
+			return decimal.Zero, decimal.Zero, fmt.Errorf("failed to calculate discount factor of value %d: %w", i, err)
+		}
+
+		value := amount.Decimal().Mul(factor)
+		sum = sum.Add(value)
+		derivative = derivative.Sub(t.Mul(value))
+	}
+
+	return sum.Round(places), derivative.DivRound(base, places), nil
+}
+
+// solve returns the rate where the discounted sum of the amounts is zero.
+// This uses the Newton-Raphson method.
+func (s Solver) solve(amounts []money.Value, times []decimal.Decimal) (money.Rate, error) {
+	if err := checkSigns(amounts); err != nil {
+		return money.Rate{}, err
+	}
+
+	places, tolerance, maxIterations := s.precision(), s.tolerance(), s.maxIterations()
+	minusOne := decimal.NewFromInt(-1)
+
+	rate := s.Guess.Ratio()
+	if err := checkRate(rate); err != nil {
+		return money.Rate{}, fmt.Errorf("invalid guess: %w", err)
+	}
+
+	for i := 0; i < maxIterations; i++ {
+		sum, derivative, err := discount(rate, amounts, times, places)
+		if err != nil {
+			return money.Rate{}, err
+		}
+		if derivative.IsZero() {
+			return money.Rate{}, &ErrorNoConvergence{i + 1, "derivative is zero"}
+		}
+
+		next := rate.Sub(sum.DivRound(derivative, places))
+		// Don't step to or beyond -100%, go halfway there instead.
+		if next.LessThanOrEqual(minusOne) {
+			next = rate.Add(minusOne).DivRound(decimal.NewFromInt(2), places)
+		}
+
+		if next.Sub(rate).Abs().LessThan(tolerance) {
+			return money.NewRate(next), nil
+		}
+		rate = next
+	}
+
+	return money.Rate{}, &ErrorNoConvergence{maxIterations, ""}
+}
+
+// IRR returns the internal rate of return of the given periodic values.
+// The first value is at the start of the first period, so it is not discounted.
+// This is the rate where the net present value of all values is zero.
+//
+// The currencies must not differ, and there must be at least one positive and one negative value.
+func (s Solver) IRR(values []money.Value) (money.Rate, error) {
+	if _, err := checkAmounts(values); err != nil {
+		return money.Rate{}, err
+	}
+
+	times := make([]decimal.Decimal, len(values))
+	for i := range times {
+		times[i] = decimal.NewFromInt(int64(i))
+	}
+
+	return s.solve(values, times)
+}
+
+// XIRR returns the annual internal rate of return of the given cash flows.
+// This is the rate where the XNPV of all cash flows is zero.
+//
+// The currencies must not differ, and there must be at least one positive and one negative cash flow.
+// No cash flow must be before the first one.
+func (s Solver) XIRR(flows []CashFlow) (money.Rate, error) {
+	_, amounts, times, err := flowTimes(flows)
+	if err != nil {
+		return money.Rate{}, err
+	}
+
+	return s.solve(amounts, times)
+}
+
+// IRR returns the internal rate of return of the given periodic values by using the default solver.
+// See Solver.IRR for details.
+//
+//	IRR([]money.Value{money.MustFromString("-100 ISO4217-EUR"), money.MustFromString("110 ISO4217-EUR")}) // Returns 10%.
+func IRR(values []money.Value) (money.Rate, error) {
+	return Solver{}.IRR(values)
+}
+
+// XIRR returns the annual internal rate of return of the given cash flows by using the default solver.
+// See Solver.XIRR for details.
+func XIRR(flows []CashFlow) (money.Rate, error) {
+	return Solver{}.XIRR(flows)
+}
+
+// NPV returns the net present value of the given periodic values.
+// Like in spreadsheet applications, the first value is at the end of the first period, so it is discounted once.
+// The result is not rounded to the smallest unit of the currency.
+//
+// The currencies must not differ, and the rate must be larger than -100%.
+//
+//	NPV(money.MustParseRate("10%"), []money.Value{money.MustFromString("110 ISO4217-EUR"), money.MustFromString("121 ISO4217-EUR")}) // Returns 200 ISO4217-EUR.
+func NPV(rate money.Rate, values []money.Value) (money.Value, error) {
+	cur, err := checkAmounts(values)
+	if err != nil {
+		return money.Value{}, err
+	}
+	if err := checkRate(rate.Ratio()); err != nil {
+		return money.Value{}, err
+	}
+
+	times := make([]decimal.Decimal, len(values))
+	for i := range times {
+		times[i] = decimal.NewFromInt(int64(i + 1))
+	}
+
+	sum, _, err := discount(rate.Ratio(), values, times, precision)
+	if err != nil {
+		return money.Value{}, err
+	}
+
+	return money.FromDecimal(sum, cur), nil
+}
+
+// XNPV returns the net present value of the given cash flows at the date of the first cash flow.
+// The cash flows are discounted by the annual rate with a year of 365 days.
+// The result is not rounded to the smallest unit of the currency.
+//
+// The currencies must not differ, the rate must be larger than -100%, and no cash flow must be before the first one.
+func XNPV(rate money.Rate, flows []CashFlow) (money.Value, error) {
+	cur, amounts, times, err := flowTimes(flows)
+	if err != nil {
+		return money.Value{}, err
+	}
+	if err := checkRate(rate.Ratio()); err != nil {
+		return money.Value{}, err
+	}
+
+	sum, _, err := discount(rate.Ratio(), amounts, times, precision)
+	if err != nil {
+		return money.Value{}, err
+	}
+
+	return money.FromDecimal(sum, cur), nil
+}
+
+// annuityFactors returns the factors (1+rate)^periods and the value of an annuity of 1 per period at the end of all periods.
+func annuityFactors(rate decimal.Decimal, periods int, timing PaymentTiming) (growth, annuity decimal.Decimal, err error) {
+	if periods < 0 {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("number of periods must not be negative")
+	}
+	if err := checkRate(rate); err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	switch timing {
+	case EndOfPeriod, BeginningOfPeriod:
+	default:
+		return decimal.Zero, decimal.Zero, fmt.Errorf("unknown payment timing %d", timing)
+	}
+
+	one := decimal.NewFromInt(1)
+	growth = powInt(one.Add(rate), periods, precision)
+
+	// Without interest, the payment timing doesn't matter.
+	if rate.IsZero() {
+		return growth, decimal.NewFromInt(int64(periods)), nil
+	}
+	annuity = growth.Sub(one).DivRound(rate, precision)
+
+	if timing == BeginningOfPeriod {
+		annuity = annuity.Mul(one.Add(rate)).Round(precision)
+	}
+
+	return growth, annuity, nil
+}
+
+// FV returns the future value of an investment with periodic constant payments and a constant interest rate per period.
+// Like in spreadsheet applications, payments that are made are negative, and the result has the opposite sign of the inputs.
+// The result is not rounded to the smallest unit of the currency.
+//
+// The currencies of payment and presentValue must not differ.
+//
+//	FV(money.MustParseRate("10%"), 2, money.MustFromString("0 ISO4217-EUR"), money.MustFromString("-100 ISO4217-EUR"), EndOfPeriod) // Returns 121 ISO4217-EUR.
+func FV(rate money.Rate, periods int, payment, presentValue money.Value, timing PaymentTiming) (money.Value, error) {
+	cur, err := checkAmounts([]money.Value{payment, presentValue})
+	if err != nil {
+		return money.Value{}, err
+	}
+
+	growth, annuity, err := annuityFactors(rate.Ratio(), periods, timing)
+	if err != nil {
+		return money.Value{}, err
+	}
+
+	// fv = -(pv * (1+r)^n + pmt * annuity).
+	fv := presentValue.Decimal().Mul(growth).Add(payment.Decimal().Mul(annuity)).Neg()
+
+	return money.FromDecimal(fv.Round(precision), cur), nil
+}
+
+// PV returns the present value of an investment with periodic constant payments and a constant interest rate per period.
+// Like in spreadsheet applications, payments that are made are negative, and the result has the opposite sign of the inputs.
+// The result is not rounded to the smallest unit of the currency.
+//
+// The currencies of payment and futureValue must not differ.
+//
+//	PV(money.MustParseRate("10%"), 2, money.MustFromString("0 ISO4217-EUR"), money.MustFromString("121 ISO4217-EUR"), EndOfPeriod) // Returns -100 ISO4217-EUR.
+func PV(rate money.Rate, periods int, payment, futureValue money.Value, timing PaymentTiming) (money.Value, error) {
+	cur, err := checkAmounts([]money.Value{payment, futureValue})
+	if err != nil {
+		return money.Value{}, err
+	}
+
+	growth, annuity, err := annuityFactors(rate.Ratio(), periods, timing)
+	if err != nil {
+		return money.Value{}, err
+	}
+
+	// pv = -(fv + pmt * annuity) / (1+r)^n.
+	pv := futureValue.Decimal().Add(payment.Decimal().Mul(annuity)).Neg().DivRound(growth, precision)
+
+	return money.FromDecimal(pv, cur), nil
+}
+
+// PMT returns the constant payment per period of a loan or an investment with a constant interest rate per period.
+// Like in spreadsheet applications, payments that are made are negative, and the result has the opposite sign of the inputs.
+// The result is not rounded to the smallest unit of the currency.
+//
+// The currencies of presentValue and futureValue must not differ, and the number of periods must be positive.
+//
+//	PMT(money.MustParseRate("0%"), 10, money.MustFromString("1000 ISO4217-EUR"), money.MustFromString("0 ISO4217-EUR"), EndOfPeriod) // Returns -100 ISO4217-EUR.
+func PMT(rate money.Rate, periods int, presentValue, futureValue money.Value, timing PaymentTiming) (money.Value, error) {
+	cur, err := checkAmounts([]money.Value{presentValue, futureValue})
+	if err != nil {
+		return money.Value{}, err
+	}
+	if periods <= 0 {
+		return money.Value{}, fmt.Errorf("number of periods must be positive")
+	}
+
+	growth, annuity, err := annuityFactors(rate.Ratio(), periods, timing)
+	if err != nil {
+		return money.Value{}, err
+	}
+
+	// pmt = -(pv * (1+r)^n + fv) / annuity.
+	pmt := presentValue.Decimal().Mul(growth).Add(futureValue.Decimal()).Neg().DivRound(annuity, precision)
+
+	return money.FromDecimal(pmt, cur), nil
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package finance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/shopspring/decimal"
+)
+
+// monthlyRate returns the given annual rate divided by 12.
+func monthlyRate(annual string) money.Rate {
+	return money.NewRate(money.MustParseRate(annual).Ratio().DivRound(decimal.NewFromInt(12), precision))
+}
+
+func valuesFromStrings(strs ...string) []money.Value {
+	values := make([]money.Value, len(strs))
+	for i, str := range strs {
+		values[i] = money.MustFromString(str)
+	}
+	return values
+}
+
+func exampleFlows() []CashFlow {
+	return []CashFlow{
+		{time.Date(2008, 1, 1, 0, 0, 0, 0, time.UTC), money.MustFromString("-10000 ISO4217-EUR")},
+		{time.Date(2008, 3, 1, 0, 0, 0, 0, time.UTC), money.MustFromString("2750 ISO4217-EUR")},
+		{time.Date(2008, 10, 30, 0, 0, 0, 0, time.UTC), money.MustFromString("4250 ISO4217-EUR")},
+		{time.Date(2009, 2, 15, 0, 0, 0, 0, time.UTC), money.MustFromString("3250 ISO4217-EUR")},
+		{time.Date(2009, 4, 1, 0, 0, 0, 0, time.UTC), money.MustFromString("2750 ISO4217-EUR")},
+	}
+}
+
+func TestNPV(t *testing.T) {
+	type args struct {
+		rate   money.Rate
+		values []money.Value
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    money.Value
+		places  int
+		wantErr bool
+	}{
+		{"comment_1", args{money.MustParseRate("10%"), valuesFromStrings("110 ISO4217-EUR", "121 ISO4217-EUR")}, money.MustFromString("200 ISO4217-EUR"), 20, false},
+		{"1", args{money.MustParseRate("10%"), valuesFromStrings("-10000 ISO4217-EUR", "3000 ISO4217-EUR", "4200 ISO4217-EUR", "6800 ISO4217-EUR")}, money.MustFromString("1188.44 ISO4217-EUR"), 2, false},
+		{"2", args{money.MustParseRate("0%"), valuesFromStrings("1", "2", "3")}, money.MustFromString("6"), 20, false},
+		{"3", args{money.MustParseRate("10%"), valuesFromStrings("1 ISO4217-EUR", "2")}, money.Value{}, 0, true},
+		{"4", args{money.MustParseRate("-100%"), valuesFromStrings("1")}, money.Value{}, 0, true},
+		{"5", args{money.MustParseRate("10%"), nil}, money.Value{}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NPV(tt.args.rate, tt.args.values)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NPV() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if rounded := money.FromDecimal(got.Decimal().Round(int32(tt.places)), got.Currency()); !rounded.Equal(tt.want) {
+				t.Errorf("NPV() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestXNPV(t *testing.T) {
+	got, err := XNPV(money.MustParseRate("9%"), exampleFlows())
+	if err != nil {
+		t.Fatalf("XNPV() failed: %v", err)
+	}
+	if want := money.MustFromString("2086.65 ISO4217-EUR"); !money.FromDecimal(got.Decimal().Round(2), got.Currency()).Equal(want) {
+		t.Errorf("XNPV() = %v, want %v", got, want)
+	}
+
+	// Cash flows before the first one are not allowed.
+	flows := exampleFlows()
+	flows[0], flows[1] = flows[1], flows[0]
+	if _, err := XNPV(money.MustParseRate("9%"), flows); err == nil {
+		t.Errorf("XNPV() didn't return an error for unordered cash flows")
+	}
+}
+
+func TestIRR(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []money.Value
+		want    string
+		wantErr bool
+	}{
+		{"comment_1", valuesFromStrings("-100 ISO4217-EUR", "110 ISO4217-EUR"), "0.1", false},
+		{"1", valuesFromStrings("-70000", "12000", "15000", "18000", "21000", "26000"), "0.086631", false},
+		{"2", valuesFromStrings("-70000", "12000", "15000", "18000", "21000"), "-0.021245", false},
+		{"3", valuesFromStrings("-100", "50", "50"), "0", false},
+		{"4", valuesFromStrings("100", "50", "50"), "", true},
+		{"5", valuesFromStrings("-100 ISO4217-EUR", "110"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IRR(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IRR() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if want := decimal.RequireFromString(tt.want); !got.Ratio().Round(6).Equal(want) {
+				t.Errorf("IRR() = %v, want %v", got.Ratio(), want)
+			}
+		})
+	}
+}
+
+func TestXIRR(t *testing.T) {
+	got, err := XIRR(exampleFlows())
+	if err != nil {
+		t.Fatalf("XIRR() failed: %v", err)
+	}
+	if want := decimal.RequireFromString("0.373363"); !got.Ratio().Round(6).Equal(want) {
+		t.Errorf("XIRR() = %v, want %v", got.Ratio(), want)
+	}
+
+	// The XNPV at the resulting rate must be zero.
+	xnpv, err := XNPV(got, exampleFlows())
+	if err != nil {
+		t.Fatalf("XNPV() failed: %v", err)
+	}
+	if !xnpv.Decimal().Round(6).IsZero() {
+		t.Errorf("XNPV() at the XIRR is %v, want 0", xnpv)
+	}
+}
+
+func TestSolver_NoConvergence(t *testing.T) {
+	solver := Solver{MaxIterations: 2, Tolerance: decimal.New(1, -30)}
+
+	_, err := solver.IRR(valuesFromStrings("-70000", "12000", "15000", "18000", "21000", "26000"))
+	var errNoConvergence *ErrorNoConvergence
+	if !errors.As(err, &errNoConvergence) {
+		t.Errorf("Solver.IRR() error = %v, want ErrorNoConvergence", err)
+	} else if errNoConvergence.Iterations() != 2 || errNoConvergence.Reason() != "" {
+		t.Errorf("ErrorNoConvergence has %d iterations and reason %q, want 2 and \"\"", errNoConvergence.Iterations(), errNoConvergence.Reason())
+	}
+
+	// With a larger tolerance the same solver is fine.
+	solver.Tolerance, solver.MaxIterations = decimal.New(1, -1), 10
+	if _, err := solver.IRR(valuesFromStrings("-70000", "12000", "15000", "18000", "21000", "26000")); err != nil {
+		t.Errorf("Solver.IRR() failed: %v", err)
+	}
+}
+
+func TestFV(t *testing.T) {
+	type args struct {
+		rate         money.Rate
+		periods      int
+		payment      money.Value
+		presentValue money.Value
+		timing       PaymentTiming
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    money.Value
+		wantErr bool
+	}{
+		{"comment_1", args{money.MustParseRate("10%"), 2, money.MustFromString("0 ISO4217-EUR"), money.MustFromString("-100 ISO4217-EUR"), EndOfPeriod}, money.MustFromString("121 ISO4217-EUR"), false},
+		{"1", args{money.MustParseRate("0.5%"), 10, money.MustFromString("-200 ISO4217-EUR"), money.MustFromString("-500 ISO4217-EUR"), BeginningOfPeriod}, money.MustFromString("2581.40 ISO4217-EUR"), false},
+		{"2", args{money.MustParseRate("0%"), 10, money.MustFromString("-200"), money.MustFromString("-500"), BeginningOfPeriod}, money.MustFromString("2500"), false},
+		{"3", args{money.MustParseRate("10%"), 0, money.MustFromString("-200"), money.MustFromString("-500"), EndOfPeriod}, money.MustFromString("500"), false},
+		{"4", args{money.MustParseRate("10%"), -1, money.MustFromString("-200"), money.MustFromString("-500"), EndOfPeriod}, money.Value{}, true},
+		{"5", args{money.MustParseRate("10%"), 1, money.MustFromString("-200 ISO4217-EUR"), money.MustFromString("-500"), EndOfPeriod}, money.Value{}, true},
+		{"6", args{money.MustParseRate("0%"), 10, money.MustFromString("-200"), money.MustFromString("-500"), PaymentTiming(2)}, money.Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FV(tt.args.rate, tt.args.periods, tt.args.payment, tt.args.presentValue, tt.args.timing)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FV() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if rounded := money.FromDecimal(got.Decimal().Round(2), got.Currency()); !rounded.Equal(tt.want) {
+				t.Errorf("FV() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPV(t *testing.T) {
+	type args struct {
+		rate        money.Rate
+		periods     int
+		payment     money.Value
+		futureValue money.Value
+		timing      PaymentTiming
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    money.Value
+		wantErr bool
+	}{
+		{"comment_1", args{money.MustParseRate("10%"), 2, money.MustFromString("0 ISO4217-EUR"), money.MustFromString("121 ISO4217-EUR"), EndOfPeriod}, money.MustFromString("-100 ISO4217-EUR"), false},
+		{"1", args{monthlyRate("8%"), 240, money.MustFromString("500 ISO4217-USD"), money.MustFromString("0 ISO4217-USD"), EndOfPeriod}, money.MustFromString("-59777.15 ISO4217-USD"), false},
+		{"2", args{money.MustParseRate("0%"), 10, money.MustFromString("-100"), money.MustFromString("0"), EndOfPeriod}, money.MustFromString("1000"), false},
+		{"3", args{money.MustParseRate("10%"), 1, money.MustFromString("-100"), money.MustFromString("0"), PaymentTiming(2)}, money.Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PV(tt.args.rate, tt.args.periods, tt.args.payment, tt.args.futureValue, tt.args.timing)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PV() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if rounded := money.FromDecimal(got.Decimal().Round(2), got.Currency()); !rounded.Equal(tt.want) {
+				t.Errorf("PV() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPMT(t *testing.T) {
+	type args struct {
+		rate         money.Rate
+		periods      int
+		presentValue money.Value
+		futureValue  money.Value
+		timing       PaymentTiming
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    money.Value
+		wantErr bool
+	}{
+		{"comment_1", args{money.MustParseRate("0%"), 10, money.MustFromString("1000 ISO4217-EUR"), money.MustFromString("0 ISO4217-EUR"), EndOfPeriod}, money.MustFromString("-100 ISO4217-EUR"), false},
+		{"1", args{monthlyRate("8%"), 10, money.MustFromString("10000 ISO4217-USD"), money.MustFromString("0 ISO4217-USD"), EndOfPeriod}, money.MustFromString("-1037.03 ISO4217-USD"), false},
+		{"2", args{money.MustParseRate("10%"), 2, money.MustFromString("0"), money.MustFromString("-231"), EndOfPeriod}, money.MustFromString("110"), false},
+		{"3", args{money.MustParseRate("10%"), 2, money.MustFromString("0"), money.MustFromString("-231"), BeginningOfPeriod}, money.MustFromString("100"), false},
+		{"4", args{money.MustParseRate("10%"), 0, money.MustFromString("100"), money.MustFromString("0"), EndOfPeriod}, money.Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PMT(tt.args.rate, tt.args.periods, tt.args.presentValue, tt.args.futureValue, tt.args.timing)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PMT() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if rounded := money.FromDecimal(got.Decimal().Round(2), got.Currency()); !rounded.Equal(tt.want) {
+				t.Errorf("PMT() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package invoice calculates the totals of invoices that consist of line items with quantities, unit prices, discounts and taxes.
+//
+// All printed amounts are rounded to the smallest unit of the currency, and it is guaranteed that the amounts of all lines sum exactly to the totals of the invoice.
+package invoice
+
+import (
+	"fmt"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/Dadido3/D3money/tax"
+	"github.com/shopspring/decimal"
+)
+
+// LineItem is a single position of an invoice.
+type LineItem struct {
+	Description string          // Description of the item. This is not used in calculations.
+	Quantity    decimal.Decimal // Quantity of the item, e.g. 3 pieces or 1.5 hours.
+	UnitPrice   money.Value     // UnitPrice is the price of a single unit. Depending on the mode of the invoice, this is a net or a gross price.
+	Discount    money.Rate      // Discount that is subtracted from the line, e.g. 15%.
+	TaxRate     money.Rate      // TaxRate that applies to the line, e.g. 19%.
+}
+
+// Line contains the calculated amounts of a single line item.
+// Subtotal minus Discount plus Adjustment is always exactly Amount, and Net plus Tax is always exactly Gross.
+type Line struct {
+	Item       LineItem    // Item is the line item the amounts were calculated from.
+	Subtotal   money.Value // Subtotal is the quantity multiplied by the unit price.
+	Discount   money.Value // Discount is the discount rate of the item applied to the subtotal. It is zero for items without discount.
+	Adjustment money.Value // Adjustment is the rounding difference that is needed for the lines to sum to the rounded invoice total. It is always zero with tax.RoundPerLine.
+	Amount     money.Value // Amount is the subtotal minus the discount plus the adjustment. Depending on the mode of the invoice, this is a net or a gross amount.
+	Net        money.Value // Net is the amount without tax.
+	Tax        money.Value // Tax is the tax of the line.
+	Gross      money.Value // Gross is the amount including tax.
+}
+
+// TaxGroup contains the sums of all lines with the same tax rate.
+type TaxGroup struct {
+	Rate  money.Rate  // Rate is the tax rate of all lines in this group.
+	Net   money.Value // Net is the sum of the net amounts of all lines in this group.
+	Tax   money.Value // Tax is the sum of the taxes of all lines in this group.
+	Gross money.Value // Gross is the sum of the gross amounts of all lines in this group.
+}
+
+// Totals contains the calculated amounts of an invoice.
+// The amounts of all lines and of all tax groups sum exactly to Net, Tax and Gross.
+type Totals struct {
+	Lines     []Line     // Lines contains the calculated amounts of every line item, in the same order as the items of the invoice.
+	TaxGroups []TaxGroup // TaxGroups contains the sums for every tax rate, in the order of their first appearance.
+	Net       money.Value
+	Tax       money.Value
+	Gross     money.Value
+}
+
+// Invoice is a list of line items and the rules how to calculate their totals.
+type Invoice struct {
+	Mode     tax.Mode             // Mode defines whether the unit prices are net or gross prices.
+	Rounding money.RoundingMode   // Rounding is the rounding mode that is used to round amounts to the smallest unit of the currency.
+	Strategy tax.RoundingStrategy // Strategy defines whether amounts are rounded for every line, or once for the whole invoice.
+	Items    []LineItem           // Items contains all positions of the invoice. The unit prices of all items must have the same currency.
+}
+
+// Totals calculates the amounts of all lines and the totals of the invoice.
+//
+// How the amounts are rounded depends on the rounding strategy of the invoice:
+//
+//   - tax.RoundPerLine: The subtotal, discount and tax of every line are rounded, the totals are the sums of the rounded lines.
+//   - tax.RoundPerInvoice: The exact amounts of all lines are summed and rounded once.
+//     The rounded sums are then distributed to the lines, so that the printed lines still sum to the printed totals.
+//     The subtotal and discount of every line are rounded like with tax.RoundPerLine, the remaining difference to the distributed amount is stored in the adjustment of the line.
+//     Lines with negative amounts, like credits or refunds, receive negative parts, see tax.Distribute.
+func (inv Invoice) Totals() (Totals, error) {
+	if len(inv.Items) == 0 {
+		return Totals{}, fmt.Errorf("invoice has no line items")
+	}
+
+	lines, err := inv.lines()
+	if err != nil {
+		return Totals{}, err
+	}
+
+	// Group the lines by their tax rates.
+	var rates []money.Rate
+	groupLines := map[string][]int{}
+	for i, line := range lines {
+		key := line.Item.TaxRate.Ratio().String()
+		if _, ok := groupLines[key]; !ok {
+			rates = append(rates, line.Item.TaxRate)
+		}
+		groupLines[key] = append(groupLines[key], i)
+	}
+
+	// Calculate the taxes of every group.
+	taxGroups := make([]TaxGroup, len(rates))
+	for i, rate := range rates {
+		indices := groupLines[rate.Ratio().String()]
+
+		amounts := make([]money.Value, len(indices))
+		for j, index := range indices {
+			amounts[j] = lines[index].Amount
+		}
+
+		c := tax.Calculator{Mode: inv.Mode, Rates: []tax.Rate{{Rate: rate}}, Rounding: inv.Rounding, Strategy: inv.Strategy}
+		breakdowns, total, err := c.CalculateLines(amounts)
+		if err != nil {
+			return Totals{}, fmt.Errorf("failed to calculate tax with rate %v: %w", rate, err)
+		}
+
+		for j, index := range indices {
+			lines[index].Net, lines[index].Tax, lines[index].Gross = breakdowns[j].Net, breakdowns[j].Tax(), breakdowns[j].Gross
+		}
+		taxGroups[i] = TaxGroup{Rate: rate, Net: total.Net, Tax: total.Tax(), Gross: total.Gross}
+	}
+
+	totals := Totals{Lines: lines, TaxGroups: taxGroups, Net: taxGroups[0].Net, Tax: taxGroups[0].Tax, Gross: taxGroups[0].Gross}
+	for _, group := range taxGroups[1:] {
+		totals.Net, totals.Tax, totals.Gross = totals.Net.MustAdd(group.Net), totals.Tax.MustAdd(group.Tax), totals.Gross.MustAdd(group.Gross)
+	}
+
+	return totals, nil
+}
+
+// lines calculates the subtotal, discount and amount of every line item.
+func (inv Invoice) lines() ([]Line, error) {
+	lines := make([]Line, len(inv.Items))
+
+	// Exact amounts of every line.
+	subtotals, amounts := make([]money.Value, len(inv.Items)), make([]money.Value, len(inv.Items))
+	for i, item := range inv.Items {
+		subtotal := item.UnitPrice.MustMul(money.FromDecimal(item.Quantity, nil))
+		subtotals[i], amounts[i] = subtotal, subtotal.MustSub(subtotal.MustMul(item.Discount.Value()))
+		lines[i].Item = item
+	}
+
+	// This also makes sure that all lines use the same currency.
+	exactTotal, err := money.Sum(amounts[0], amounts[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch inv.Strategy {
+	case tax.RoundPerLine:
+		for i, item := range inv.Items {
+			subtotal, err := subtotals[i].Round(inv.Rounding)
+			if err != nil {
+				return nil, fmt.Errorf("failed to round subtotal of line %d: %w", i, err)
+			}
+			discount, err := subtotal.ApplyPercent(item.Discount, inv.Rounding)
+			if err != nil {
+				return nil, fmt.Errorf("failed to calculate discount of line %d: %w", i, err)
+			}
+			lines[i].Subtotal, lines[i].Discount, lines[i].Amount = subtotal, discount, subtotal.MustSub(discount)
+			lines[i].Adjustment = money.FromDecimal(decimal.Zero, subtotal.Currency())
+		}
+
+	case tax.RoundPerInvoice:
+		total, err := exactTotal.Round(inv.Rounding)
+		if err != nil {
+			return nil, fmt.Errorf("failed to round total: %w", err)
+		}
+
+		// Distribute the rounded total proportionally to the exact amounts.
+		weights := make([]decimal.Decimal, len(amounts))
+		for i, amount := range amounts {
+			weights[i] = amount.Decimal()
+		}
+		// Negative weights of credit lines result in negative parts.
+		parts, err := tax.Distribute(total, weights)
+		if err != nil {
+			return nil, fmt.Errorf("failed to distribute total %v to the lines: %w", total, err)
+		}
+
+		for i, item := range inv.Items {
+			subtotal, err := subtotals[i].Round(inv.Rounding)
+			if err != nil {
+				return nil, fmt.Errorf("failed to round subtotal of line %d: %w", i, err)
+			}
+			discount, err := subtotal.ApplyPercent(item.Discount, inv.Rounding)
+			if err != nil {
+				return nil, fmt.Errorf("failed to calculate discount of line %d: %w", i, err)
+			}
+			lines[i].Subtotal, lines[i].Discount, lines[i].Amount = subtotal, discount, parts[i]
+			lines[i].Adjustment = parts[i].MustSub(subtotal.MustSub(discount))
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown rounding strategy %d", inv.Strategy)
+	}
+
+	return lines, nil
+}
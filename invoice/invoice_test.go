@@ -0,0 +1,201 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package invoice
+
+import (
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/Dadido3/D3money/tax"
+	"github.com/shopspring/decimal"
+)
+
+// checkTotals checks if the lines and tax groups sum exactly to the totals.
+func checkTotals(t *testing.T, totals Totals) {
+	t.Helper()
+
+	net, tx, gross := totals.Lines[0].Net, totals.Lines[0].Tax, totals.Lines[0].Gross
+	for _, line := range totals.Lines[1:] {
+		net, tx, gross = net.MustAdd(line.Net), tx.MustAdd(line.Tax), gross.MustAdd(line.Gross)
+	}
+	if !net.Equal(totals.Net) || !tx.Equal(totals.Tax) || !gross.Equal(totals.Gross) {
+		t.Errorf("Lines sum to %v, %v, %v, want %v, %v, %v", net, tx, gross, totals.Net, totals.Tax, totals.Gross)
+	}
+
+	for i, line := range totals.Lines {
+		if !line.Subtotal.MustSub(line.Discount).MustAdd(line.Adjustment).Equal(line.Amount) {
+			t.Errorf("Line %d: Subtotal %v minus discount %v plus adjustment %v is not amount %v", i, line.Subtotal, line.Discount, line.Adjustment, line.Amount)
+		}
+		if line.Item.Discount.Ratio().IsZero() && !line.Discount.IsZero() {
+			t.Errorf("Line %d: Discount is %v, but the item has no discount", i, line.Discount)
+		}
+		if !line.Net.MustAdd(line.Tax).Equal(line.Gross) {
+			t.Errorf("Line %d: Net %v plus tax %v is not gross %v", i, line.Net, line.Tax, line.Gross)
+		}
+	}
+
+	if !totals.Net.MustAdd(totals.Tax).Equal(totals.Gross) {
+		t.Errorf("Net %v plus tax %v is not gross %v", totals.Net, totals.Tax, totals.Gross)
+	}
+}
+
+func TestInvoice_Totals(t *testing.T) {
+	items := []LineItem{
+		{Description: "A", Quantity: decimal.NewFromInt(3), UnitPrice: money.MustFromString("0.333 ISO4217-EUR"), TaxRate: money.MustParseRate("19%")},
+		{Description: "B", Quantity: decimal.NewFromInt(1), UnitPrice: money.MustFromString("9.99 ISO4217-EUR"), Discount: money.MustParseRate("15%"), TaxRate: money.MustParseRate("19%")},
+		{Description: "C", Quantity: decimal.New(25, -1), UnitPrice: money.MustFromString("4 ISO4217-EUR"), TaxRate: money.MustParseRate("7%")},
+	}
+
+	a := LineItem{Description: "A", Quantity: decimal.NewFromInt(1), UnitPrice: money.MustFromString("0.333 ISO4217-EUR"), TaxRate: money.MustParseRate("19%")}
+
+	tests := []struct {
+		name                          string
+		inv                           Invoice
+		wantAmounts, wantTaxes        []string
+		wantNet, wantTax, wantGross   string
+		wantGroupNets, wantGroupTaxes []string
+	}{
+		{"1", Invoice{Strategy: tax.RoundPerLine, Items: items},
+			[]string{"1", "8.49", "10"}, []string{"0.19", "1.61", "0.7"},
+			"19.49", "2.5", "21.99",
+			[]string{"9.49", "10"}, []string{"1.8", "0.7"}},
+		{"2", Invoice{Strategy: tax.RoundPerInvoice, Items: items},
+			[]string{"1", "8.49", "10"}, []string{"0.19", "1.61", "0.7"},
+			"19.49", "2.5", "21.99",
+			[]string{"9.49", "10"}, []string{"1.8", "0.7"}},
+		{"3", Invoice{Strategy: tax.RoundPerLine, Items: []LineItem{items[1], items[1], items[1]}},
+			[]string{"8.49", "8.49", "8.49"}, []string{"1.61", "1.61", "1.61"},
+			"25.47", "4.83", "30.3",
+			[]string{"25.47"}, []string{"4.83"}},
+		{"4", Invoice{Strategy: tax.RoundPerInvoice, Items: []LineItem{items[1], items[1], items[1]}},
+			[]string{"8.49", "8.49", "8.49"}, []string{"1.62", "1.61", "1.61"},
+			"25.47", "4.84", "30.31",
+			[]string{"25.47"}, []string{"4.84"}},
+		{"5", Invoice{Strategy: tax.RoundPerLine, Items: []LineItem{a, a, a}},
+			[]string{"0.33", "0.33", "0.33"}, []string{"0.06", "0.06", "0.06"},
+			"0.99", "0.18", "1.17",
+			[]string{"0.99"}, []string{"0.18"}},
+		{"6", Invoice{Strategy: tax.RoundPerInvoice, Items: []LineItem{a, a, a}},
+			[]string{"0.34", "0.33", "0.33"}, []string{"0.07", "0.06", "0.06"},
+			"1", "0.19", "1.19",
+			[]string{"1"}, []string{"0.19"}},
+		{"7", Invoice{Mode: tax.Inclusive, Strategy: tax.RoundPerLine, Items: []LineItem{items[1], items[2]}},
+			[]string{"8.49", "10"}, []string{"1.36", "0.65"},
+			"16.48", "2.01", "18.49",
+			[]string{"7.13", "9.35"}, []string{"1.36", "0.65"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.inv.Totals()
+			if err != nil {
+				t.Fatalf("Invoice.Totals() failed: %v", err)
+			}
+
+			checkTotals(t, got)
+
+			eur := money.ISO4217Currencies.ByCode("EUR")
+			for i, line := range got.Lines {
+				if !line.Amount.Equal(money.MustFromStringAndCurrency(tt.wantAmounts[i], eur)) {
+					t.Errorf("Lines[%d].Amount = %v, want %v", i, line.Amount, tt.wantAmounts[i])
+				}
+				if !line.Tax.Equal(money.MustFromStringAndCurrency(tt.wantTaxes[i], eur)) {
+					t.Errorf("Lines[%d].Tax = %v, want %v", i, line.Tax, tt.wantTaxes[i])
+				}
+			}
+			if !got.Net.Equal(money.MustFromStringAndCurrency(tt.wantNet, eur)) {
+				t.Errorf("Net = %v, want %v", got.Net, tt.wantNet)
+			}
+			if !got.Tax.Equal(money.MustFromStringAndCurrency(tt.wantTax, eur)) {
+				t.Errorf("Tax = %v, want %v", got.Tax, tt.wantTax)
+			}
+			if !got.Gross.Equal(money.MustFromStringAndCurrency(tt.wantGross, eur)) {
+				t.Errorf("Gross = %v, want %v", got.Gross, tt.wantGross)
+			}
+			if len(got.TaxGroups) != len(tt.wantGroupNets) {
+				t.Fatalf("Got %d tax groups, want %d", len(got.TaxGroups), len(tt.wantGroupNets))
+			}
+			for i, group := range got.TaxGroups {
+				if !group.Net.Equal(money.MustFromStringAndCurrency(tt.wantGroupNets[i], eur)) {
+					t.Errorf("TaxGroups[%d].Net = %v, want %v", i, group.Net, tt.wantGroupNets[i])
+				}
+				if !group.Tax.Equal(money.MustFromStringAndCurrency(tt.wantGroupTaxes[i], eur)) {
+					t.Errorf("TaxGroups[%d].Tax = %v, want %v", i, group.Tax, tt.wantGroupTaxes[i])
+				}
+			}
+		})
+	}
+}
+
+func TestInvoice_Totals_Adjustment(t *testing.T) {
+	a := LineItem{Description: "A", Quantity: decimal.NewFromInt(1), UnitPrice: money.MustFromString("0.333 ISO4217-EUR"), TaxRate: money.MustParseRate("19%")}
+	b := LineItem{Description: "B", Quantity: decimal.NewFromInt(1), UnitPrice: money.MustFromString("9.99 ISO4217-EUR"), Discount: money.MustParseRate("15%"), TaxRate: money.MustParseRate("19%")}
+
+	got, err := Invoice{Strategy: tax.RoundPerInvoice, Items: []LineItem{a, a, a, b}}.Totals()
+	if err != nil {
+		t.Fatalf("Invoice.Totals() failed: %v", err)
+	}
+
+	checkTotals(t, got)
+
+	// Leftover cents of the distribution must not show up as discount.
+	for i, line := range got.Lines[:3] {
+		if !line.Discount.IsZero() {
+			t.Errorf("Lines[%d].Discount = %v, want 0", i, line.Discount)
+		}
+	}
+	if want := money.MustFromString("1.50 ISO4217-EUR"); !got.Lines[3].Discount.Equal(want) {
+		t.Errorf("Lines[3].Discount = %v, want %v", got.Lines[3].Discount, want)
+	}
+
+	// The first line receives the leftover cent of the three items A.
+	if want := money.MustFromString("0.01 ISO4217-EUR"); !got.Lines[0].Adjustment.Equal(want) {
+		t.Errorf("Lines[0].Adjustment = %v, want %v", got.Lines[0].Adjustment, want)
+	}
+}
+
+func TestInvoice_Totals_Credit(t *testing.T) {
+	a := LineItem{Description: "A", Quantity: decimal.NewFromInt(3), UnitPrice: money.MustFromString("3.333 ISO4217-EUR"), TaxRate: money.MustParseRate("19%")}
+	credit := LineItem{Description: "Refund", Quantity: decimal.NewFromInt(-1), UnitPrice: money.MustFromString("2.505 ISO4217-EUR"), TaxRate: money.MustParseRate("19%")}
+	b := LineItem{Description: "B", Quantity: decimal.NewFromInt(1), UnitPrice: money.MustFromString("0.333 ISO4217-EUR"), TaxRate: money.MustParseRate("7%")}
+
+	for _, mode := range []tax.Mode{tax.Exclusive, tax.Inclusive} {
+		got, err := Invoice{Mode: mode, Strategy: tax.RoundPerInvoice, Items: []LineItem{a, credit, b, credit}}.Totals()
+		if err != nil {
+			t.Fatalf("Invoice.Totals() with credit lines failed: %v", err)
+		}
+
+		checkTotals(t, got)
+
+		// The amounts of the lines sum to the rounded total of the exact amounts 9.999 - 2.505 + 0.333 - 2.505 = 5.322.
+		sum := got.Lines[0].Amount
+		for _, line := range got.Lines[1:] {
+			sum = sum.MustAdd(line.Amount)
+		}
+		if want := money.MustFromString("5.32 ISO4217-EUR"); !sum.Equal(want) {
+			t.Errorf("Line amounts sum to %v, want %v", sum, want)
+		}
+		for _, i := range []int{1, 3} {
+			if !got.Lines[i].Amount.IsNegative() || !got.Lines[i].Tax.IsNegative() {
+				t.Errorf("Credit line %d has amount %v and tax %v, want negative ones", i, got.Lines[i].Amount, got.Lines[i].Tax)
+			}
+		}
+	}
+}
+
+func TestInvoice_Totals_Errors(t *testing.T) {
+	invoices := []Invoice{
+		{},
+		{Items: []LineItem{{Quantity: decimal.NewFromInt(1), UnitPrice: money.MustFromString("1 ISO4217-EUR")}, {Quantity: decimal.NewFromInt(1), UnitPrice: money.MustFromString("1 ISO4217-USD")}}},
+		{Items: []LineItem{{Quantity: decimal.NewFromInt(1), UnitPrice: money.MustFromString("1")}}},
+		{Strategy: tax.RoundingStrategy(-1), Items: []LineItem{{Quantity: decimal.NewFromInt(1), UnitPrice: money.MustFromString("1 ISO4217-EUR")}}},
+	}
+
+	for i, inv := range invoices {
+		if _, err := inv.Totals(); err == nil {
+			t.Errorf("Invoice %d: Invoice.Totals() did not fail", i)
+		}
+	}
+}
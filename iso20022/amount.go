@@ -0,0 +1,219 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package iso20022 contains amount types for ISO 20022 messages, like SEPA credit transfers (pain.001) or bank statements (camt.053).
+//
+// The types wrap a monetary value and can be used with encoding/xml directly:
+//
+//	type CreditTransferTransaction struct {
+//		InstdAmt iso20022.ActiveOrHistoricCurrencyAndAmount `xml:"Amt>InstdAmt"` // Marshals into <Amt><InstdAmt Ccy="EUR">123.45</InstdAmt></Amt>.
+//	}
+//
+// Values are validated when they are marshalled and unmarshalled, so invalid amounts are neither written into nor read from messages.
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strings"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	MaxFractionDigits = 5  // MaxFractionDigits is the maximum number of fraction digits of an amount.
+	MaxTotalDigits    = 18 // MaxTotalDigits is the maximum number of digits of an amount.
+)
+
+// regexAmount matches the lexical representation of xs:decimal.
+var regexAmount = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+
+// ActiveOrHistoricCurrencyAndAmount is a non-negative amount with an active or historic ISO 4217 currency.
+//
+// The currency codes are looked up in money.ISO4217Currencies.
+// As this library only contains active ISO 4217 currencies, this type accepts the same values as ActiveCurrencyAndAmount.
+type ActiveOrHistoricCurrencyAndAmount struct {
+	V money.Value
+}
+
+// NewActiveOrHistoricCurrencyAndAmount returns v as ActiveOrHistoricCurrencyAndAmount, or an error if v is not valid.
+func NewActiveOrHistoricCurrencyAndAmount(v money.Value) (ActiveOrHistoricCurrencyAndAmount, error) {
+	if err := validate(v); err != nil {
+		return ActiveOrHistoricCurrencyAndAmount{}, err
+	}
+	return ActiveOrHistoricCurrencyAndAmount{v}, nil
+}
+
+// Validate checks if the value follows the rules of ActiveOrHistoricCurrencyAndAmount:
+//   - It has an ISO 4217 currency.
+//   - It is not negative.
+//   - It has at most MaxFractionDigits fraction digits, and not more than the smallest unit of its currency.
+//   - It has at most MaxTotalDigits digits.
+func (a ActiveOrHistoricCurrencyAndAmount) Validate() error {
+	return validate(a.V)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (a ActiveOrHistoricCurrencyAndAmount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeAmount(e, start, a.V)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (a *ActiveOrHistoricCurrencyAndAmount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v, err := decodeAmount(d, start)
+	if err != nil {
+		return err
+	}
+
+	a.V = v
+	return nil
+}
+
+// ActiveCurrencyAndAmount is a non-negative amount with an active ISO 4217 currency.
+//
+// The currency codes are looked up in money.ISO4217Currencies.
+type ActiveCurrencyAndAmount struct {
+	V money.Value
+}
+
+// NewActiveCurrencyAndAmount returns v as ActiveCurrencyAndAmount, or an error if v is not valid.
+//
+//	iso20022.NewActiveCurrencyAndAmount(money.MustFromString("123.45 ISO4217-EUR"))  // Returns 123.45 EUR.
+//	iso20022.NewActiveCurrencyAndAmount(money.MustFromString("123.456 ISO4217-EUR")) // Returns an error, as EUR has only 2 fraction digits.
+//	iso20022.NewActiveCurrencyAndAmount(money.MustFromString("-1 ISO4217-EUR"))      // Returns an error, as the amount is negative.
+func NewActiveCurrencyAndAmount(v money.Value) (ActiveCurrencyAndAmount, error) {
+	if err := validate(v); err != nil {
+		return ActiveCurrencyAndAmount{}, err
+	}
+	return ActiveCurrencyAndAmount{v}, nil
+}
+
+// Validate checks if the value follows the rules of ActiveCurrencyAndAmount.
+// See ActiveOrHistoricCurrencyAndAmount.Validate for the rules.
+func (a ActiveCurrencyAndAmount) Validate() error {
+	return validate(a.V)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (a ActiveCurrencyAndAmount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeAmount(e, start, a.V)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (a *ActiveCurrencyAndAmount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v, err := decodeAmount(d, start)
+	if err != nil {
+		return err
+	}
+
+	a.V = v
+	return nil
+}
+
+// amountElement is the XML representation of an amount.
+type amountElement struct {
+	Amount   string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+// encodeAmount validates v and writes it as XML element with the given start element to e.
+// The amount is written with the number of fraction digits of the currency, e.g. <InstdAmt Ccy="EUR">12.30</InstdAmt>.
+func encodeAmount(e *xml.Encoder, start xml.StartElement, v money.Value) error {
+	if err := validate(v); err != nil {
+		return err
+	}
+
+	cur := v.Currency()
+	amount := v.Decimal().String()
+	if digits, ok := currencyFractionDigits(cur); ok {
+		amount = v.Decimal().StringFixed(int32(digits))
+	}
+
+	return e.EncodeElement(amountElement{Amount: amount, Currency: cur.Code()}, start)
+}
+
+// decodeAmount reads the amount of the XML element with the given start element from d and validates it.
+func decodeAmount(d *xml.Decoder, start xml.StartElement) (money.Value, error) {
+	var elem amountElement
+	if err := d.DecodeElement(&elem, &start); err != nil {
+		return money.Value{}, err
+	}
+
+	amountStr, code := strings.TrimSpace(elem.Amount), strings.TrimSpace(elem.Currency)
+	if !regexAmount.MatchString(amountStr) {
+		return money.Value{}, fmt.Errorf("amount %q of element %s is not a decimal number", amountStr, start.Name.Local)
+	}
+	amount, err := decimal.NewFromString(amountStr)
+	if err != nil {
+		return money.Value{}, fmt.Errorf("failed to parse amount %q: %w", amountStr, err)
+	}
+
+	if code == "" {
+		return money.Value{}, fmt.Errorf("element %s has no Ccy attribute", start.Name.Local)
+	}
+	cur := money.ISO4217Currencies.ByCode(code)
+	if cur == nil {
+		return money.Value{}, fmt.Errorf("can't find ISO 4217 currency with code %q", code)
+	}
+
+	v := money.FromDecimal(amount, cur)
+	if err := validate(v); err != nil {
+		return money.Value{}, err
+	}
+
+	return v, nil
+}
+
+// validate checks if v is a valid ISO 20022 amount.
+// See ActiveOrHistoricCurrencyAndAmount.Validate for the rules.
+func validate(v money.Value) error {
+	cur := v.Currency()
+	if cur == nil {
+		return fmt.Errorf("amount %s has no currency", v)
+	}
+	if money.ISO4217Currencies.ByCode(cur.Code()) != cur {
+		return fmt.Errorf("currency %q is not an ISO 4217 currency", cur.UniqueCode())
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("amount %s is negative", v)
+	}
+
+	fractionDigits, totalDigits := countDigits(v.Decimal())
+
+	maxFractionDigits := MaxFractionDigits
+	if digits, ok := currencyFractionDigits(cur); ok && digits < maxFractionDigits {
+		maxFractionDigits = digits
+	}
+	if fractionDigits > maxFractionDigits {
+		return &ErrorFractionDigits{value: v, digits: fractionDigits, maxDigits: maxFractionDigits}
+	}
+	if totalDigits > MaxTotalDigits {
+		return &ErrorTotalDigits{value: v, digits: totalDigits}
+	}
+
+	return nil
+}
+
+// currencyFractionDigits returns the number of fraction digits of the smallest unit of cur.
+// The result is false if the currency has no smallest unit.
+func currencyFractionDigits(cur money.Currency) (int, bool) {
+	smallestUnit := cur.SmallestUnit()
+	if !smallestUnit.IsPositive() {
+		return 0, false
+	}
+
+	digits, _ := countDigits(smallestUnit.Decimal())
+	return digits, true
+}
+
+// countDigits returns the number of fraction digits and the total number of digits of d, ignoring leading and trailing zeros.
+func countDigits(d decimal.Decimal) (fractionDigits, totalDigits int) {
+	// String doesn't output trailing zeros of the fraction.
+	intPart, fracPart, _ := strings.Cut(d.Abs().String(), ".")
+
+	return len(fracPart), len(strings.TrimLeft(intPart+fracPart, "0"))
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+)
+
+func TestNewActiveCurrencyAndAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   money.Value
+		wantErr bool
+	}{
+		{"comment_1", money.MustFromString("123.45 ISO4217-EUR"), false},
+		{"comment_2", money.MustFromString("123.456 ISO4217-EUR"), true},
+		{"comment_3", money.MustFromString("-1 ISO4217-EUR"), true},
+		{"1", money.MustFromString("0 ISO4217-EUR"), false},
+		{"2", money.MustFromString("123.4500 ISO4217-EUR"), false},
+		{"3", money.MustFromString("123.4 ISO4217-JPY"), true},
+		{"4", money.MustFromString("123 ISO4217-JPY"), false},
+		{"5", money.MustFromString("1.234 ISO4217-BHD"), false},
+		{"6", money.MustFromString("1.23456 ISO4217-XAU"), false},
+		{"7", money.MustFromString("1.234567 ISO4217-XAU"), true},
+		{"8", money.MustFromString("9999999999999999.99 ISO4217-EUR"), false},
+		{"9", money.MustFromString("99999999999999999.99 ISO4217-EUR"), true},
+		{"10", money.MustFromString("1e17 ISO4217-EUR"), false},
+		{"11", money.MustFromString("1e18 ISO4217-EUR"), true},
+		{"12", money.MustFromString("123.45"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewActiveCurrencyAndAmount(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewActiveCurrencyAndAmount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !got.V.Equal(tt.value) {
+				t.Errorf("NewActiveCurrencyAndAmount() = %v, want %v", got.V, tt.value)
+			}
+
+			if _, err := NewActiveOrHistoricCurrencyAndAmount(tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("NewActiveOrHistoricCurrencyAndAmount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	var errFraction *ErrorFractionDigits
+	if err := (ActiveCurrencyAndAmount{money.MustFromString("1.001 ISO4217-EUR")}).Validate(); !errors.As(err, &errFraction) {
+		t.Errorf("ActiveCurrencyAndAmount.Validate() error = %v, want ErrorFractionDigits", err)
+	} else if !errFraction.Value().Equal(money.MustFromString("1.001 ISO4217-EUR")) || errFraction.Digits() != 3 || errFraction.MaxDigits() != 2 {
+		t.Errorf("ErrorFractionDigits has value %v, %d digits and %d max digits, want 1.001 EUR, 3 and 2", errFraction.Value(), errFraction.Digits(), errFraction.MaxDigits())
+	}
+	var errTotal *ErrorTotalDigits
+	if err := (ActiveCurrencyAndAmount{money.MustFromString("1234567890123456789 ISO4217-EUR")}).Validate(); !errors.As(err, &errTotal) {
+		t.Errorf("ActiveCurrencyAndAmount.Validate() error = %v, want ErrorTotalDigits", err)
+	} else if !errTotal.Value().Equal(money.MustFromString("1234567890123456789 ISO4217-EUR")) || errTotal.Digits() != 19 || errTotal.MaxDigits() != MaxTotalDigits {
+		t.Errorf("ErrorTotalDigits has value %v, %d digits and %d max digits, want 1234567890123456789 EUR, 19 and %d", errTotal.Value(), errTotal.Digits(), errTotal.MaxDigits(), MaxTotalDigits)
+	}
+}
+
+func TestCreditTransfer(t *testing.T) {
+	type creditTransfer struct {
+		XMLName  xml.Name                          `xml:"CdtTrfTxInf"`
+		InstdAmt ActiveOrHistoricCurrencyAndAmount `xml:"Amt>InstdAmt"`
+		Fee      ActiveCurrencyAndAmount           `xml:"Fee"`
+	}
+
+	c := creditTransfer{
+		InstdAmt: ActiveOrHistoricCurrencyAndAmount{money.MustFromString("123.4 ISO4217-EUR")},
+		Fee:      ActiveCurrencyAndAmount{money.MustFromString("500 ISO4217-JPY")},
+	}
+	want := `<CdtTrfTxInf><Amt><InstdAmt Ccy="EUR">123.40</InstdAmt></Amt><Fee Ccy="JPY">500</Fee></CdtTrfTxInf>`
+
+	got, err := xml.Marshal(c)
+	if err != nil {
+		t.Fatalf("xml.Marshal() failed: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("xml.Marshal() = %s, want %s", got, want)
+	}
+
+	var res creditTransfer
+	if err := xml.Unmarshal(got, &res); err != nil {
+		t.Fatalf("xml.Unmarshal(%s) failed: %v", got, err)
+	}
+	if !res.InstdAmt.V.Equal(c.InstdAmt.V) || !res.Fee.V.Equal(c.Fee.V) {
+		t.Errorf("xml.Unmarshal(%s) = %+v, want %+v", got, res, c)
+	}
+
+	// Invalid values can't be marshalled.
+	c.InstdAmt.V = money.MustFromString("123.456 ISO4217-EUR")
+	if _, err := xml.Marshal(c); err == nil {
+		t.Errorf("xml.Marshal() with too many fraction digits didn't fail")
+	}
+}
+
+func TestActiveCurrencyAndAmount_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    money.Value
+		wantErr bool
+	}{
+		{"1", `<Amt Ccy="EUR">123.45</Amt>`, money.MustFromString("123.45 ISO4217-EUR"), false},
+		{"2", "<Amt Ccy=\"EUR\">\n\t123.45\n</Amt>", money.MustFromString("123.45 ISO4217-EUR"), false},
+		{"3", `<Amt Ccy="EUR">.5</Amt>`, money.MustFromString("0.5 ISO4217-EUR"), false},
+		{"4", `<Amt Ccy="EUR">1.</Amt>`, money.MustFromString("1 ISO4217-EUR"), false},
+		{"5", `<Amt Ccy="EUR">+1.00000</Amt>`, money.MustFromString("1 ISO4217-EUR"), false},
+		{"6", `<Amt Ccy="EUR">1e3</Amt>`, money.Value{}, true},
+		{"7", `<Amt Ccy="EUR">-1</Amt>`, money.Value{}, true},
+		{"8", `<Amt Ccy="EUR">1.001</Amt>`, money.Value{}, true},
+		{"9", `<Amt Ccy="EUR"></Amt>`, money.Value{}, true},
+		{"10", `<Amt>123.45</Amt>`, money.Value{}, true},
+		{"11", `<Amt Ccy="XYZ">123.45</Amt>`, money.Value{}, true},
+		{"12", `<Amt Ccy="eur">123.45</Amt>`, money.Value{}, true},
+		{"13", `<Amt Ccy="EUR">1234567890123456789</Amt>`, money.Value{}, true},
+		{"14", `<Amt Ccy="EUR">123.45`, money.Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ActiveCurrencyAndAmount
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ActiveCurrencyAndAmount.UnmarshalXML() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.V.Equal(tt.want) {
+				t.Errorf("ActiveCurrencyAndAmount.UnmarshalXML() = %v, want %v", got.V, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package iso20022
+
+import (
+	"fmt"
+
+	money "github.com/Dadido3/D3money"
+)
+
+// ErrorFractionDigits is returned when an amount has more fraction digits than allowed by ISO 20022 or its currency.
+type ErrorFractionDigits struct {
+	value             money.Value
+	digits, maxDigits int
+}
+
+func (e *ErrorFractionDigits) Error() string {
+	return fmt.Sprintf("amount %s has %d fraction digits, at most %d are allowed", e.value, e.digits, e.maxDigits)
+}
+
+// Value returns the value that has too many fraction digits.
+func (e *ErrorFractionDigits) Value() money.Value { return e.value }
+
+// Digits returns the number of fraction digits of the value.
+func (e *ErrorFractionDigits) Digits() int { return e.digits }
+
+// MaxDigits returns the maximum number of fraction digits allowed for the currency of the value.
+func (e *ErrorFractionDigits) MaxDigits() int { return e.maxDigits }
+
+// ErrorTotalDigits is returned when an amount has more than MaxTotalDigits digits.
+type ErrorTotalDigits struct {
+	value  money.Value
+	digits int
+}
+
+func (e *ErrorTotalDigits) Error() string {
+	return fmt.Sprintf("amount %s has %d digits, at most %d are allowed", e.value, e.digits, MaxTotalDigits)
+}
+
+// Value returns the value that has too many digits.
+func (e *ErrorTotalDigits) Value() money.Value { return e.value }
+
+// Digits returns the total number of digits of the value.
+func (e *ErrorTotalDigits) Digits() int { return e.digits }
+
+// MaxDigits returns the maximum number of digits, which is always MaxTotalDigits.
+func (e *ErrorTotalDigits) MaxDigits() int { return MaxTotalDigits }
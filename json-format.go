@@ -0,0 +1,369 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// JSONAmount defines how the amount of a value is represented in JSON.
+type JSONAmount int
+
+const (
+	JSONAmountString     JSONAmount = iota // JSONAmountString represents the amount as decimal string, e.g. "12.34". This is the default.
+	JSONAmountNumber                       // JSONAmountNumber represents the amount as JSON number, e.g. 12.34. Be aware that many JSON decoders read numbers as floats, which may lose precision.
+	JSONAmountMinorUnits                   // JSONAmountMinorUnits represents the amount as integer number of the currency's smallest unit, e.g. 1234 for 12.34 EUR.
+)
+
+// JSONCurrency defines how the currency of a value is represented in JSON.
+type JSONCurrency int
+
+const (
+	JSONCurrencyUniqueCode JSONCurrency = iota // JSONCurrencyUniqueCode represents the currency by its unique code, e.g. "ISO4217-EUR". This is the default.
+	JSONCurrencyCode                           // JSONCurrencyCode represents the currency by its code, e.g. "EUR". This is only unambiguous for currencies of a single standard.
+)
+
+// JSONFormat describes a JSON representation of monetary values.
+// The zero value describes the same representation that Value.MarshalJSON uses:
+//
+//	JSONFormat{}                                                                              // {"Amount":"12.34","Currency":"ISO4217-EUR"}
+//	JSONFormat{LowercaseKeys: true, Currency: JSONCurrencyCode}                               // {"amount":"12.34","currency":"EUR"}
+//	JSONFormat{LowercaseKeys: true, Amount: JSONAmountNumber}                                 // {"amount":12.34,"currency":"ISO4217-EUR"}
+//	JSONFormat{LowercaseKeys: true, Amount: JSONAmountMinorUnits, Currency: JSONCurrencyCode} // {"amount":1234,"currency":"EUR"}
+//	JSONFormat{String: true, Currency: JSONCurrencyCode}                                      // "12.34 EUR"
+//
+// When unmarshalling, keys are matched case-insensitively, and amounts are accepted as string and as number regardless of the Amount setting.
+type JSONFormat struct {
+	String        bool                // String represents the value as a single string "Amount Currency", e.g. "12.34 EUR", instead of an object.
+	LowercaseKeys bool                // LowercaseKeys uses the keys "amount" and "currency" instead of "Amount" and "Currency".
+	Amount        JSONAmount          // Amount defines the representation of the amount.
+	Currency      JSONCurrency        // Currency defines the representation of the currency.
+	Currencies    *CurrencyCollection // Currencies is the collection that currencies are looked up in. Defaults to the global Currencies for unique codes, and to ISO4217Currencies for codes.
+
+	// Strict enables strict unmarshalling, which rejects data that doesn't follow the format exactly:
+	//   - A missing, null or empty amount results in ErrorMissingField.
+	//   - Keys other than the ones of the format, including keys of different case, result in ErrorUnknownField.
+	//   - An amount that is a JSON number while a string is expected, or vice versa, results in ErrorInvalidAmountType.
+	//   - An amount with more precision than the smallest unit of its currency results in ErrorExceedsSmallestUnit.
+	Strict bool
+}
+
+// keys returns the keys of the amount and currency fields.
+func (f JSONFormat) keys() (amountKey, currencyKey string) {
+	if f.LowercaseKeys {
+		return "amount", "currency"
+	}
+	return "Amount", "Currency"
+}
+
+// currencies returns the collection that currencies are looked up in.
+func (f JSONFormat) currencies() *CurrencyCollection {
+	switch {
+	case f.Currencies != nil:
+		return f.Currencies
+	case f.Currency == JSONCurrencyCode:
+		// The global collection contains multiple standards, so it can't be searched by code.
+		return ISO4217Currencies
+	}
+	return Currencies
+}
+
+// currencyString returns the representation of the given currency, or an empty string if there is no currency.
+func (f JSONFormat) currencyString(cur Currency) string {
+	switch {
+	case cur == nil:
+		return ""
+	case f.Currency == JSONCurrencyCode:
+		return cur.Code()
+	}
+	return cur.UniqueCode()
+}
+
+// lookupCurrency returns the currency that matches the given representation.
+// An empty string results in no currency.
+func (f JSONFormat) lookupCurrency(str string) (Currency, error) {
+	if str == "" {
+		return nil, nil
+	}
+
+	cc := f.currencies()
+	if f.Currency == JSONCurrencyCode {
+		if cur := cc.ByCode(str); cur != nil {
+			return cur, nil
+		}
+		return nil, &ErrorCantFindCode{str}
+	}
+
+	if cur := cc.ByUniqueCode(str); cur != nil {
+		return cur, nil
+	}
+	return nil, &ErrorCantFindUniqueCode{str}
+}
+
+// Marshal returns the JSON representation of v in this format.
+func (f JSONFormat) Marshal(v Value) ([]byte, error) {
+	amount := v.amount.String()
+	if f.Amount == JSONAmountMinorUnits {
+		i, err := v.MinorUnitsBigInt()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s into minor units: %w", v, err)
+		}
+		amount = i.String()
+	}
+
+	currency := f.currencyString(v.currency)
+
+	if f.String {
+		if currency != "" {
+			return json.Marshal(amount + " " + currency)
+		}
+		return json.Marshal(amount)
+	}
+
+	amountKey, currencyKey := f.keys()
+
+	currencyJSON, err := json.Marshal(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.WriteString(`{"` + amountKey + `":`)
+	if f.Amount == JSONAmountString {
+		b.WriteString(`"` + amount + `"`)
+	} else {
+		b.WriteString(amount)
+	}
+	b.WriteString(`,"` + currencyKey + `":`)
+	b.Write(currencyJSON)
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// Unmarshal returns the value of the given JSON representation in this format.
+// JSON null results in a zero value.
+func (f JSONFormat) Unmarshal(data []byte) (Value, error) {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return Value{}, nil
+	}
+
+	var amountStr, currencyStr string
+
+	if f.String {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return Value{}, err
+		}
+
+		fields := strings.Fields(str)
+		switch len(fields) {
+		case 2:
+			currencyStr = fields[1]
+			fallthrough
+		case 1:
+			amountStr = fields[0]
+		case 0:
+			// Empty string, handled like a missing amount.
+		default:
+			return Value{}, fmt.Errorf("%q is not of the form \"Amount Currency\"", str)
+		}
+
+	} else if f.Strict {
+		var err error
+		if amountStr, currencyStr, err = f.unmarshalStrictObject(data); err != nil {
+			return Value{}, err
+		}
+
+	} else {
+		d := struct {
+			Amount   json.RawMessage
+			Currency string
+		}{}
+		if err := json.Unmarshal(data, &d); err != nil {
+			return Value{}, err
+		}
+
+		var err error
+		if amountStr, err = jsonAmountString(d.Amount); err != nil {
+			return Value{}, err
+		}
+		currencyStr = d.Currency
+	}
+
+	cur, err := f.lookupCurrency(currencyStr)
+	if err != nil {
+		return Value{}, err
+	}
+
+	if amountStr == "" {
+		if f.Strict {
+			amountKey, _ := f.keys()
+			return Value{}, &ErrorMissingField{amountKey}
+		}
+		return Value{currency: cur}, nil
+	}
+
+	if f.Amount == JSONAmountMinorUnits {
+		i, ok := new(big.Int).SetString(amountStr, 10)
+		if !ok {
+			return Value{}, fmt.Errorf("amount %q is not an integer number of minor units", amountStr)
+		}
+		return FromMinorUnitsBigInt(i, cur)
+	}
+
+	amount, err := decimal.NewFromString(amountStr)
+	if err != nil {
+		return Value{}, fmt.Errorf("failed to parse amount %q: %w", amountStr, err)
+	}
+
+	v := Value{amount: amount, currency: cur}
+	if f.Strict && cur != nil {
+		if smallestUnit := cur.SmallestUnit(); smallestUnit.amount.Sign() > 0 && !amount.Mod(smallestUnit.amount).IsZero() {
+			return Value{}, &ErrorExceedsSmallestUnit{v}
+		}
+	}
+
+	return v, nil
+}
+
+// unmarshalStrictObject returns the amount and currency strings of the given JSON object.
+// See JSONFormat.Strict for the rules.
+func (f JSONFormat) unmarshalStrictObject(data []byte) (amountStr, currencyStr string, err error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", "", err
+	}
+
+	amountKey, currencyKey := f.keys()
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key != amountKey && key != currencyKey {
+			return "", "", &ErrorUnknownField{key}
+		}
+	}
+
+	raw := bytes.TrimSpace(fields[amountKey])
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", "", &ErrorMissingField{amountKey}
+	}
+
+	want := "number"
+	if f.Amount == JSONAmountString {
+		want = "string"
+	}
+	if got := jsonKind(raw); got != want {
+		return "", "", &ErrorInvalidAmountType{got: got, want: want}
+	}
+
+	if amountStr, err = jsonAmountString(raw); err != nil {
+		return "", "", err
+	}
+
+	if raw, ok := fields[currencyKey]; ok && string(bytes.TrimSpace(raw)) != "null" {
+		if err := json.Unmarshal(raw, &currencyStr); err != nil {
+			return "", "", fmt.Errorf("failed to unmarshal currency: %w", err)
+		}
+	}
+
+	return amountStr, currencyStr, nil
+}
+
+// jsonKind returns the kind of the given JSON value, e.g. "string" or "number".
+func jsonKind(raw json.RawMessage) string {
+	switch raw[0] {
+	case '"':
+		return "string"
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	}
+	return "number"
+}
+
+// jsonAmountString returns the content of a JSON string or the literal of a JSON number.
+// A missing amount or JSON null results in an empty string.
+func jsonAmountString(raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	if raw[0] == '"' {
+		var str string
+		if err := json.Unmarshal(raw, &str); err != nil {
+			return "", err
+		}
+		return str, nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err != nil {
+		return "", fmt.Errorf("amount %s is neither a string nor a number: %w", raw, err)
+	}
+	return number.String(), nil
+}
+
+// JSONFormatter is implemented by types that define a JSON format for JSONFormatValue.
+type JSONFormatter interface {
+	JSONFormat() JSONFormat
+}
+
+// JSONFormatValue is a monetary value that is marshalled to and unmarshalled from JSON in the format defined by F.
+// This allows to use different JSON representations for different struct fields:
+//
+//	type apiFormat struct{}
+//
+//	func (apiFormat) JSONFormat() money.JSONFormat {
+//		return money.JSONFormat{LowercaseKeys: true, Currency: money.JSONCurrencyCode}
+//	}
+//
+//	type Invoice struct {
+//		Total money.JSONFormatValue[apiFormat] `json:"total"` // Marshals into {"amount":"12.34","currency":"EUR"}.
+//	}
+type JSONFormatValue[F JSONFormatter] struct {
+	V Value
+}
+
+// MarshalJSON returns the JSON representation of the value in the format defined by F.
+func (j JSONFormatValue[F]) MarshalJSON() ([]byte, error) {
+	var f F
+	return f.JSONFormat().Marshal(j.V)
+}
+
+// UnmarshalJSON fills the object with data matching the JSON representation in the format defined by F.
+// JSON null leaves the value unchanged, like encoding/json does for other types.
+func (j *JSONFormatValue[F]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+
+	var f F
+	v, err := f.JSONFormat().Unmarshal(data)
+	if err != nil {
+		return err
+	}
+
+	j.V = v
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestJSONFormat(t *testing.T) {
+	eur := MustFromString("12.34 ISO4217-EUR")
+
+	tests := []struct {
+		name    string
+		format  JSONFormat
+		value   Value
+		want    string
+		wantErr bool
+	}{
+		{"comment_1", JSONFormat{}, eur, `{"Amount":"12.34","Currency":"ISO4217-EUR"}`, false},
+		{"comment_2", JSONFormat{LowercaseKeys: true, Currency: JSONCurrencyCode}, eur, `{"amount":"12.34","currency":"EUR"}`, false},
+		{"comment_3", JSONFormat{LowercaseKeys: true, Amount: JSONAmountNumber}, eur, `{"amount":12.34,"currency":"ISO4217-EUR"}`, false},
+		{"comment_4", JSONFormat{LowercaseKeys: true, Amount: JSONAmountMinorUnits, Currency: JSONCurrencyCode}, eur, `{"amount":1234,"currency":"EUR"}`, false},
+		{"comment_5", JSONFormat{String: true, Currency: JSONCurrencyCode}, eur, `"12.34 EUR"`, false},
+		{"1", JSONFormat{String: true}, eur, `"12.34 ISO4217-EUR"`, false},
+		{"2", JSONFormat{String: true}, MustFromString("-12.34"), `"-12.34"`, false},
+		{"3", JSONFormat{Currency: JSONCurrencyCode}, MustFromString("-12.34"), `{"Amount":"-12.34","Currency":""}`, false},
+		{"4", JSONFormat{Amount: JSONAmountMinorUnits}, MustFromString("1234 ISO4217-JPY"), `{"Amount":1234,"Currency":"ISO4217-JPY"}`, false},
+		{"5", JSONFormat{Amount: JSONAmountMinorUnits}, MustFromString("12.345 ISO4217-EUR"), ``, true},
+		{"6", JSONFormat{Amount: JSONAmountMinorUnits}, MustFromString("12.34"), ``, true},
+		{"7", JSONFormat{String: true, Amount: JSONAmountMinorUnits, Currency: JSONCurrencyCode}, eur, `"1234 EUR"`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.format.Marshal(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JSONFormat.Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("JSONFormat.Marshal() = %s, want %s", got, tt.want)
+			}
+
+			// Check roundtrip.
+			v, err := tt.format.Unmarshal(got)
+			if err != nil {
+				t.Fatalf("JSONFormat.Unmarshal(%s) failed: %v", got, err)
+			}
+			if !v.Equal(tt.value) {
+				t.Errorf("JSONFormat.Unmarshal(%s) = %v, want %v", got, v, tt.value)
+			}
+		})
+	}
+}
+
+func TestJSONFormat_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  JSONFormat
+		data    string
+		want    Value
+		wantErr bool
+	}{
+		{"1", JSONFormat{}, `{"amount":12.34,"currency":"ISO4217-EUR"}`, MustFromString("12.34 ISO4217-EUR"), false},
+		{"2", JSONFormat{}, `{"Amount":"12.34"}`, MustFromString("12.34"), false},
+		{"3", JSONFormat{}, `null`, Value{}, false},
+		{"4", JSONFormat{}, `{"Amount":true}`, Value{}, true},
+		{"5", JSONFormat{}, `{"Amount":"abc"}`, Value{}, true},
+		{"6", JSONFormat{}, `{"Amount":"1","Currency":"EUR"}`, Value{}, true},
+		{"7", JSONFormat{Currency: JSONCurrencyCode}, `{"Amount":"1","Currency":"ISO4217-EUR"}`, Value{}, true},
+		{"8", JSONFormat{Currency: JSONCurrencyCode}, `{"amount":"1e2","currency":"USD"}`, MustFromString("100 ISO4217-USD"), false},
+		{"9", JSONFormat{Amount: JSONAmountMinorUnits}, `{"amount":"1234","currency":"ISO4217-EUR"}`, MustFromString("12.34 ISO4217-EUR"), false},
+		{"10", JSONFormat{Amount: JSONAmountMinorUnits}, `{"amount":12.5,"currency":"ISO4217-EUR"}`, Value{}, true},
+		{"11", JSONFormat{Amount: JSONAmountMinorUnits}, `{"amount":1234}`, Value{}, true},
+		{"12", JSONFormat{String: true}, `" 12.34  ISO4217-EUR "`, MustFromString("12.34 ISO4217-EUR"), false},
+		{"13", JSONFormat{String: true}, `"12.34 ISO4217-EUR foo"`, Value{}, true},
+		{"14", JSONFormat{String: true}, `{"Amount":"12.34"}`, Value{}, true},
+		{"15", JSONFormat{Currency: JSONCurrencyCode, Currencies: MustNewCurrencyCollection("Test", "FOO", []Currency{testCurrency1})}, `{"Amount":"1","Currency":"BAR"}`, FromDecimal(MustFromString("1").amount, testCurrency1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.format.Unmarshal([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JSONFormat.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !got.Equal(tt.want) {
+				t.Errorf("JSONFormat.Unmarshal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONFormat_Strict(t *testing.T) {
+	var errMissing *ErrorMissingField
+	var errUnknown *ErrorUnknownField
+	var errType *ErrorInvalidAmountType
+	var errSmallestUnit *ErrorExceedsSmallestUnit
+
+	tests := []struct {
+		name    string
+		format  JSONFormat
+		data    string
+		want    Value
+		wantErr interface{}
+	}{
+		{"1", JSONFormat{Strict: true}, `{"Amount":"12.34","Currency":"ISO4217-EUR"}`, MustFromString("12.34 ISO4217-EUR"), nil},
+		{"2", JSONFormat{Strict: true}, `{"Amount":"12.34"}`, MustFromString("12.34"), nil},
+		{"3", JSONFormat{Strict: true}, `{"Currency":"ISO4217-EUR"}`, Value{}, &errMissing},
+		{"4", JSONFormat{Strict: true}, `{"Amount":null,"Currency":"ISO4217-EUR"}`, Value{}, &errMissing},
+		{"5", JSONFormat{Strict: true}, `{"Amount":"","Currency":"ISO4217-EUR"}`, Value{}, &errMissing},
+		{"6", JSONFormat{Strict: true}, `{"Amount":"12.34","Currency":"ISO4217-EUR","Note":"foo"}`, Value{}, &errUnknown},
+		{"7", JSONFormat{Strict: true}, `{"amount":"12.34","currency":"ISO4217-EUR"}`, Value{}, &errUnknown},
+		{"8", JSONFormat{Strict: true}, `{"Amount":12.34,"Currency":"ISO4217-EUR"}`, Value{}, &errType},
+		{"9", JSONFormat{Strict: true}, `{"Amount":true}`, Value{}, &errType},
+		{"10", JSONFormat{Strict: true, Amount: JSONAmountNumber}, `{"Amount":"12.34"}`, Value{}, &errType},
+		{"11", JSONFormat{Strict: true, Amount: JSONAmountNumber}, `{"Amount":12.34}`, MustFromString("12.34"), nil},
+		{"12", JSONFormat{Strict: true}, `{"Amount":"12.345","Currency":"ISO4217-EUR"}`, Value{}, &errSmallestUnit},
+		{"13", JSONFormat{Strict: true}, `{"Amount":"12.340","Currency":"ISO4217-EUR"}`, MustFromString("12.34 ISO4217-EUR"), nil},
+		{"14", JSONFormat{Strict: true}, `{"Amount":"12.345"}`, MustFromString("12.345"), nil},
+		{"15", JSONFormat{Strict: true, String: true}, `"12.345 ISO4217-EUR"`, Value{}, &errSmallestUnit},
+		{"16", JSONFormat{Strict: true, String: true}, `""`, Value{}, &errMissing},
+		{"17", JSONFormat{Strict: true, LowercaseKeys: true, Amount: JSONAmountMinorUnits, Currency: JSONCurrencyCode}, `{"amount":1234,"currency":"EUR"}`, MustFromString("12.34 ISO4217-EUR"), nil},
+		{"18", JSONFormat{Strict: true}, `{"Amount":"12.34","Currency":978}`, Value{}, new(*json.UnmarshalTypeError)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.format.Unmarshal([]byte(tt.data))
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("JSONFormat.Unmarshal() error = %v, wantErr %T", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.As(err, tt.wantErr) {
+					t.Errorf("JSONFormat.Unmarshal() error = %v (%T), want error of type %T", err, err, tt.wantErr)
+				}
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("JSONFormat.Unmarshal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Check structured error information.
+	_, err := JSONFormat{Strict: true}.Unmarshal([]byte(`{"Amount":"1","Foo":1}`))
+	if !errors.As(err, &errUnknown) || errUnknown.Field() != "Foo" {
+		t.Errorf("JSONFormat.Unmarshal() error = %v, want unknown field \"Foo\"", err)
+	}
+	_, err = JSONFormat{Strict: true}.Unmarshal([]byte(`{"Amount":"1.001","Currency":"ISO4217-EUR"}`))
+	if !errors.As(err, &errSmallestUnit) || !errSmallestUnit.Value().Equal(MustFromString("1.001 ISO4217-EUR")) || !errSmallestUnit.SmallestUnit().Equal(MustFromString("0.01 ISO4217-EUR")) {
+		t.Errorf("JSONFormat.Unmarshal() error = %v, want value 1.001 ISO4217-EUR and smallest unit 0.01 ISO4217-EUR", err)
+	}
+	_, err = JSONFormat{Strict: true}.Unmarshal([]byte(`{"Amount":12.34}`))
+	if !errors.As(err, &errType) || errType.Got() != "number" || errType.Want() != "string" {
+		t.Errorf("JSONFormat.Unmarshal() error = %v, want amount type number instead of string", err)
+	}
+}
+
+type testJSONFormat struct{}
+
+func (testJSONFormat) JSONFormat() JSONFormat {
+	return JSONFormat{LowercaseKeys: true, Amount: JSONAmountMinorUnits, Currency: JSONCurrencyCode}
+}
+
+func TestJSONFormatValue(t *testing.T) {
+	type invoice struct {
+		Total JSONFormatValue[testJSONFormat] `json:"total"`
+		Tax   JSONFormatValue[testJSONFormat] `json:"tax"`
+	}
+
+	in := invoice{
+		Total: JSONFormatValue[testJSONFormat]{MustFromString("12.34 ISO4217-EUR")},
+		Tax:   JSONFormatValue[testJSONFormat]{MustFromString("1.97 ISO4217-EUR")},
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %v", err)
+	}
+	if want := `{"total":{"amount":1234,"currency":"EUR"},"tax":{"amount":197,"currency":"EUR"}}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var out invoice
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	if !out.Total.V.Equal(in.Total.V) || !out.Tax.V.Equal(in.Tax.V) {
+		t.Errorf("json.Unmarshal() = %v, want %v", out, in)
+	}
+
+	// JSON null leaves the value unchanged.
+	if err := json.Unmarshal([]byte(`{"total":null}`), &out); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	if !out.Total.V.Equal(in.Total.V) {
+		t.Errorf("json.Unmarshal() with null changed the value to %v", out.Total.V)
+	}
+}
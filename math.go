@@ -28,3 +28,65 @@ func MustSum(first Value, values ...Value) Value {
 	}
 	return res
 }
+
+// Min returns the smallest of all given values.
+// The currencies must not differ.
+//
+//	Min(MustFromString("12.34 ISO4217-EUR"), MustFromString("-12.34 ISO4217-EUR")) // Returns -12.34 ISO4217-EUR.
+//	Min(MustFromString("12.34 ISO4217-EUR"), MustFromString("12.34"))              // Returns an error.
+func Min(first Value, values ...Value) (Value, error) {
+	smallest := first
+	for _, value := range values {
+		if first.currency != value.currency {
+			return Value{}, &ErrorDifferentCurrencies{first.currency, value.currency}
+		}
+		if value.amount.LessThan(smallest.amount) {
+			smallest = value
+		}
+	}
+
+	return smallest, nil
+}
+
+// MustMin returns the smallest of all given values.
+// The currencies must not differ.
+//
+// Use this version if you have already made sure that the currencies are equal between all values.
+func MustMin(first Value, values ...Value) Value {
+	res, err := Min(first, values...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Max returns the largest of all given values.
+// The currencies must not differ.
+//
+//	Max(MustFromString("12.34 ISO4217-EUR"), MustFromString("-12.34 ISO4217-EUR")) // Returns 12.34 ISO4217-EUR.
+//	Max(MustFromString("12.34 ISO4217-EUR"), MustFromString("12.34"))              // Returns an error.
+func Max(first Value, values ...Value) (Value, error) {
+	largest := first
+	for _, value := range values {
+		if first.currency != value.currency {
+			return Value{}, &ErrorDifferentCurrencies{first.currency, value.currency}
+		}
+		if value.amount.GreaterThan(largest.amount) {
+			largest = value
+		}
+	}
+
+	return largest, nil
+}
+
+// MustMax returns the largest of all given values.
+// The currencies must not differ.
+//
+// Use this version if you have already made sure that the currencies are equal between all values.
+func MustMax(first Value, values ...Value) Value {
+	res, err := Max(first, values...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
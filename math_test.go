@@ -39,3 +39,63 @@ func TestSum(t *testing.T) {
 		})
 	}
 }
+
+func TestMin(t *testing.T) {
+	type args struct {
+		first  Value
+		values []Value
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", args{MustFromString("12.34 ISO4217-EUR"), []Value{MustFromString("-12.34 ISO4217-EUR")}}, MustFromString("-12.34 ISO4217-EUR"), false},
+		{"comment_2", args{MustFromString("12.34 ISO4217-EUR"), []Value{MustFromString("12.34")}}, Value{}, true},
+		{"1", args{MustFromString("12.34 ISO4217-EUR"), nil}, MustFromString("12.34 ISO4217-EUR"), false},
+		{"2", args{MustFromString("3"), []Value{MustFromString("1"), MustFromString("2")}}, MustFromString("1"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Min(tt.args.first, tt.args.values...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Min() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Min() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMax(t *testing.T) {
+	type args struct {
+		first  Value
+		values []Value
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", args{MustFromString("12.34 ISO4217-EUR"), []Value{MustFromString("-12.34 ISO4217-EUR")}}, MustFromString("12.34 ISO4217-EUR"), false},
+		{"comment_2", args{MustFromString("12.34 ISO4217-EUR"), []Value{MustFromString("12.34")}}, Value{}, true},
+		{"1", args{MustFromString("12.34 ISO4217-EUR"), nil}, MustFromString("12.34 ISO4217-EUR"), false},
+		{"2", args{MustFromString("1"), []Value{MustFromString("3"), MustFromString("2")}}, MustFromString("3"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Max(tt.args.first, tt.args.values...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Max() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Max() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package moneypb
+
+import (
+	"fmt"
+
+	money "github.com/Dadido3/D3money"
+)
+
+// ErrorPrecisionLoss is returned when a value has more precision than a message can represent.
+type ErrorPrecisionLoss struct{ value money.Value }
+
+func (e *ErrorPrecisionLoss) Error() string {
+	return fmt.Sprintf("value %s has more precision than nanos can represent", e.value)
+}
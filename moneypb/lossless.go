@@ -0,0 +1,104 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package moneypb
+
+import (
+	"fmt"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/shopspring/decimal"
+)
+
+// Lossless contains the fields of the D3money specific Lossless message.
+// In contrast to Money, it can represent any amount with any currency.
+type Lossless struct {
+	Amount     string // Amount is the amount as decimal string, e.g. "-12.34".
+	CurrencyID int32  // CurrencyID is the unique ID of the currency, or 0 for no currency.
+}
+
+// LosslessFromValue returns the Lossless representation of v.
+func LosslessFromValue(v money.Value) Lossless {
+	l := Lossless{Amount: v.Decimal().String()}
+	if cur := v.Currency(); cur != nil {
+		l.CurrencyID = cur.UniqueID()
+	}
+
+	return l
+}
+
+// ToValue returns l as monetary value.
+// The currency is looked up in the global money.Currencies collection.
+func (l Lossless) ToValue() (money.Value, error) {
+	return l.ToValueWithCollection(money.Currencies)
+}
+
+// ToValueWithCollection returns l as monetary value.
+// The currency is looked up in the given collection.
+func (l Lossless) ToValueWithCollection(cc *money.CurrencyCollection) (money.Value, error) {
+	// Proto3 omits empty strings, so a missing amount is zero.
+	amount := decimal.Zero
+	if l.Amount != "" {
+		var err error
+		if amount, err = decimal.NewFromString(l.Amount); err != nil {
+			return money.Value{}, fmt.Errorf("failed to parse amount %q: %w", l.Amount, err)
+		}
+	}
+
+	var cur money.Currency
+	if l.CurrencyID != 0 {
+		if cur = cc.ByUniqueID(l.CurrencyID); cur == nil {
+			return money.Value{}, fmt.Errorf("can't find currency with unique ID %d", l.CurrencyID)
+		}
+	}
+
+	return money.FromDecimal(amount, cur), nil
+}
+
+// Marshal returns the message in the protobuf wire format.
+func (l Lossless) Marshal() []byte {
+	var e encoder
+	e.stringField(1, l.Amount)
+	e.int32Field(2, l.CurrencyID)
+
+	return e.buf
+}
+
+// Unmarshal fills the message with the fields of the given protobuf wire format data.
+// Unknown fields are ignored.
+func (l *Lossless) Unmarshal(data []byte) error {
+	var res Lossless
+
+	err := decodeFields(data, func(f wireField) (err error) {
+		switch f.number {
+		case 1:
+			res.Amount, err = f.string()
+		case 2:
+			res.CurrencyID, err = f.int32()
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to decode Lossless: %w", err)
+	}
+
+	*l = res
+	return nil
+}
+
+// MarshalLossless returns v as Lossless message in the protobuf wire format.
+func MarshalLossless(v money.Value) []byte {
+	return LosslessFromValue(v).Marshal()
+}
+
+// UnmarshalLossless returns the value of the given Lossless message in the protobuf wire format.
+func UnmarshalLossless(data []byte) (money.Value, error) {
+	var l Lossless
+	if err := l.Unmarshal(data); err != nil {
+		return money.Value{}, err
+	}
+
+	return l.ToValue()
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package moneypb
+
+import (
+	"encoding/hex"
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+)
+
+func TestLossless(t *testing.T) {
+	tests := []struct {
+		name  string
+		value money.Value
+		want  string
+	}{
+		{"1", money.MustFromString("-12.34 ISO4217-EUR"), "0a062d31322e333410e2f48d14"},
+		{"2", money.MustFromString("0"), "0a0130"},
+		{"3", money.MustFromString("0.0000000000001 ISO4217-EUR"), "0a0f302e3030303030303030303030303110e2f48d14"},
+		{"4", money.MustFromString("123456789012345678901234567890"), "0a1e313233343536373839303132333435363738393031323334353637383930"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := MarshalLossless(tt.value)
+			if got := hex.EncodeToString(data); got != tt.want {
+				t.Errorf("MarshalLossless() = %s, want %s", got, tt.want)
+			}
+
+			got, err := UnmarshalLossless(data)
+			if err != nil {
+				t.Fatalf("UnmarshalLossless() failed: %v", err)
+			}
+			if !got.Equal(tt.value) {
+				t.Errorf("UnmarshalLossless() = %v, want %v", got, tt.value)
+			}
+		})
+	}
+}
+
+func TestLossless_ToValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		l       Lossless
+		want    money.Value
+		wantErr bool
+	}{
+		{"1", Lossless{"1.5", 42170978}, money.MustFromString("1.5 ISO4217-EUR"), false},
+		{"2", Lossless{"", 42170978}, money.MustFromString("0 ISO4217-EUR"), false},
+		{"3", Lossless{"abc", 0}, money.Value{}, true},
+		{"4", Lossless{"1", 1}, money.Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.l.ToValue()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Lossless.ToValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !got.Equal(tt.want) {
+				t.Errorf("Lossless.ToValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Scoped collection.
+	cc := money.MustNewCurrencyCollection("Test", "", []money.Currency{money.ISO4217Currencies.ByCode("USD")})
+	if _, err := (Lossless{"1", 42170978}).ToValueWithCollection(cc); err == nil {
+		t.Errorf("Lossless.ToValueWithCollection() with currency outside of the collection didn't fail")
+	}
+}
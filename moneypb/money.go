@@ -0,0 +1,155 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package moneypb converts monetary values to and from Protocol Buffers messages.
+//
+// Money is compatible with the well known google.type.Money message:
+//
+//	message Money {
+//	  string currency_code = 1;
+//	  int64 units = 2;
+//	  int32 nanos = 3;
+//	}
+//
+// Lossless is a D3money specific message that can represent any value without loss of precision:
+//
+//	message Lossless {
+//	  string amount = 1;
+//	  int32 currency_id = 2;
+//	}
+//
+// Both types contain their own encoder and decoder for the protobuf wire format, so no protobuf runtime is needed.
+// If you already use generated protobuf code, convert between the fields of the generated message and Money or Lossless instead.
+package moneypb
+
+import (
+	"fmt"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/shopspring/decimal"
+)
+
+// nanosPerUnit is the number of nanos in a single unit.
+const nanosPerUnit = 1000000000
+
+// Money contains the fields of a google.type.Money message.
+type Money struct {
+	CurrencyCode string // CurrencyCode is the three letter ISO 4217 currency code, e.g. "EUR".
+	Units        int64  // Units is the whole number of units of the amount.
+	Nanos        int32  // Nanos is the number of nano units of the amount, between -999,999,999 and +999,999,999. It has the same sign as Units, if Units is not zero.
+}
+
+// FromValue returns the google.type.Money representation of v.
+//
+// The currency of v has to be an ISO 4217 currency.
+// A value without currency results in an empty currency code.
+//
+//	moneypb.FromValue(money.MustFromString("-12.34 ISO4217-EUR"))        // Returns Money{"EUR", -12, -340000000}.
+//	moneypb.FromValue(money.MustFromString("0.0000000001 ISO4217-EUR"))  // Returns an error, as the amount is more precise than nanos.
+//	moneypb.FromValue(money.MustFromString("1e20 ISO4217-EUR"))          // Returns an error, as the units overflow int64.
+func FromValue(v money.Value) (Money, error) {
+	var code string
+	if cur := v.Currency(); cur != nil {
+		if money.ISO4217Currencies.ByUniqueCode(cur.UniqueCode()) == nil {
+			return Money{}, fmt.Errorf("currency %q is not an ISO 4217 currency", cur.UniqueCode())
+		}
+		code = cur.Code()
+	}
+
+	amount := v.Decimal()
+	units := amount.Truncate(0)
+	nanos := amount.Sub(units).Shift(9)
+
+	if !nanos.IsInteger() {
+		return Money{}, &ErrorPrecisionLoss{v}
+	}
+	if !units.BigInt().IsInt64() {
+		return Money{}, fmt.Errorf("units of %s overflow int64", v)
+	}
+
+	return Money{CurrencyCode: code, Units: units.IntPart(), Nanos: int32(nanos.IntPart())}, nil
+}
+
+// Validate checks if the fields follow the rules of google.type.Money, and if the currency code is known.
+func (m Money) Validate() error {
+	_, err := m.ToValue()
+	return err
+}
+
+// ToValue returns m as monetary value.
+// The currency code is looked up in money.ISO4217Currencies, an empty currency code results in a value without currency.
+func (m Money) ToValue() (money.Value, error) {
+	if m.Nanos <= -nanosPerUnit || m.Nanos >= nanosPerUnit {
+		return money.Value{}, fmt.Errorf("nanos %d are outside of the range of -999,999,999 to +999,999,999", m.Nanos)
+	}
+	if (m.Units > 0 && m.Nanos < 0) || (m.Units < 0 && m.Nanos > 0) {
+		return money.Value{}, fmt.Errorf("units %d and nanos %d have different signs", m.Units, m.Nanos)
+	}
+
+	var cur money.Currency
+	if m.CurrencyCode != "" {
+		if cur = money.ISO4217Currencies.ByCode(m.CurrencyCode); cur == nil {
+			return money.Value{}, fmt.Errorf("can't find ISO 4217 currency with code %q", m.CurrencyCode)
+		}
+	}
+
+	amount := decimal.New(m.Units, 0).Add(decimal.New(int64(m.Nanos), -9))
+
+	return money.FromDecimal(amount, cur), nil
+}
+
+// Marshal returns the message in the protobuf wire format.
+func (m Money) Marshal() []byte {
+	var e encoder
+	e.stringField(1, m.CurrencyCode)
+	e.int64Field(2, m.Units)
+	e.int32Field(3, m.Nanos)
+
+	return e.buf
+}
+
+// Unmarshal fills the message with the fields of the given protobuf wire format data.
+// Unknown fields are ignored.
+func (m *Money) Unmarshal(data []byte) error {
+	var res Money
+
+	err := decodeFields(data, func(f wireField) (err error) {
+		switch f.number {
+		case 1:
+			res.CurrencyCode, err = f.string()
+		case 2:
+			res.Units, err = f.int64()
+		case 3:
+			res.Nanos, err = f.int32()
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to decode google.type.Money: %w", err)
+	}
+
+	*m = res
+	return nil
+}
+
+// Marshal returns v as google.type.Money message in the protobuf wire format.
+func Marshal(v money.Value) ([]byte, error) {
+	m, err := FromValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Marshal(), nil
+}
+
+// Unmarshal returns the value of the given google.type.Money message in the protobuf wire format.
+func Unmarshal(data []byte) (money.Value, error) {
+	var m Money
+	if err := m.Unmarshal(data); err != nil {
+		return money.Value{}, err
+	}
+
+	return m.ToValue()
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package moneypb
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+)
+
+func TestFromValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   money.Value
+		want    Money
+		wantErr bool
+	}{
+		{"comment_1", money.MustFromString("-12.34 ISO4217-EUR"), Money{"EUR", -12, -340000000}, false},
+		{"comment_2", money.MustFromString("0.0000000001 ISO4217-EUR"), Money{}, true},
+		{"comment_3", money.MustFromString("1e20 ISO4217-EUR"), Money{}, true},
+		{"1", money.MustFromString("0.000000001 ISO4217-USD"), Money{"USD", 0, 1}, false},
+		{"2", money.MustFromString("-0.5 ISO4217-USD"), Money{"USD", 0, -500000000}, false},
+		{"3", money.MustFromString("9223372036854775807.999999999 ISO4217-USD"), Money{"USD", 9223372036854775807, 999999999}, false},
+		{"4", money.MustFromString("12"), Money{"", 12, 0}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromValue(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FromValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FromValue() = %v, want %v", got, tt.want)
+			}
+			if err != nil {
+				return
+			}
+
+			// Check roundtrip.
+			v, err := got.ToValue()
+			if err != nil {
+				t.Fatalf("Money.ToValue() failed: %v", err)
+			}
+			if !v.Equal(tt.value) {
+				t.Errorf("Money.ToValue() = %v, want %v", v, tt.value)
+			}
+		})
+	}
+
+	var errPrecision *ErrorPrecisionLoss
+	if _, err := FromValue(money.MustFromString("1.0000000001 ISO4217-EUR")); !errors.As(err, &errPrecision) {
+		t.Errorf("FromValue() error = %v, want ErrorPrecisionLoss", err)
+	}
+}
+
+func TestMoney_ToValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Money
+		want    money.Value
+		wantErr bool
+	}{
+		{"1", Money{"EUR", 1, 230000000}, money.MustFromString("1.23 ISO4217-EUR"), false},
+		{"2", Money{"EUR", 0, -1}, money.MustFromString("-0.000000001 ISO4217-EUR"), false},
+		{"3", Money{"EUR", 1, -1}, money.Value{}, true},
+		{"4", Money{"EUR", -1, 1}, money.Value{}, true},
+		{"5", Money{"EUR", 0, 1000000000}, money.Value{}, true},
+		{"6", Money{"EUR", 0, -1000000000}, money.Value{}, true},
+		{"7", Money{"XYZ", 1, 0}, money.Value{}, true},
+		{"8", Money{"", 1, 0}, money.MustFromString("1"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.ToValue()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Money.ToValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !got.Equal(tt.want) {
+				t.Errorf("Money.ToValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoney_Wire(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Money
+		want string
+	}{
+		{"1", Money{"EUR", -12, -340000000}, "0a0345555210f4ffffffffffffffff01188086f0ddfeffffffff01"},
+		{"2", Money{"USD", 1, 500000000}, "0a0355534410011880cab5ee01"},
+		{"3", Money{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.m.Marshal()
+			if got := hex.EncodeToString(data); got != tt.want {
+				t.Errorf("Money.Marshal() = %s, want %s", got, tt.want)
+			}
+
+			var got Money
+			if err := got.Unmarshal(data); err != nil {
+				t.Fatalf("Money.Unmarshal() failed: %v", err)
+			}
+			if got != tt.m {
+				t.Errorf("Money.Unmarshal() = %v, want %v", got, tt.m)
+			}
+		})
+	}
+}
+
+func TestMoney_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Money
+		wantErr bool
+	}{
+		{"1", "0a03455552", Money{CurrencyCode: "EUR"}, false},
+		{"2", "100110021802", Money{Units: 2, Nanos: 2}, false},                            // Last value wins.
+		{"3", "2003290102030405060708350102030410022200", Money{Units: 2}, false},          // Unknown fields of all wire types are skipped.
+		{"4", "0a0345", Money{}, true},                                                     // Truncated string.
+		{"5", "10", Money{}, true},                                                         // Missing varint.
+		{"6", "0a02c328", Money{}, true},                                                   // Invalid UTF-8.
+		{"7", "0a", Money{}, true},                                                         // Missing length.
+		{"8", "0b", Money{}, true},                                                         // Group wire type.
+		{"9", "0001", Money{}, true},                                                       // Field number 0.
+		{"10", "12034555521001", Money{}, true},                                            // Field 2 with wrong wire type.
+		{"11", "10ffffffffffffffffffff01", Money{}, true},                                  // Varint overflow.
+		{"12", "290102030405", Money{}, true},                                              // Truncated fixed64.
+		{"13", "188086f0ddfeffffffff01", Money{Nanos: -340000000}, false},                  // Sign extended int32.
+		{"14", "0a0355534410011880cab5ee01", Money{"USD", 1, 500000000}, false},            // Complete message.
+		{"15", "0a035553440a03455552", Money{CurrencyCode: "EUR"}, false},                  // Last string wins.
+		{"16", "0a035553441001188086f0ddfeffffffff01", Money{"USD", 1, -340000000}, false}, // Valid encoding of an invalid google.type.Money.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			if err != nil {
+				t.Fatalf("hex.DecodeString() failed: %v", err)
+			}
+
+			var got Money
+			err = got.Unmarshal(data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Money.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Money.Unmarshal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	values := []money.Value{
+		money.MustFromString("0"),
+		money.MustFromString("-12345.6789 ISO4217-EUR"),
+		money.MustFromString("12345.000000001 ISO4217-JPY"),
+	}
+
+	for _, value := range values {
+		data, err := Marshal(value)
+		if err != nil {
+			t.Fatalf("Marshal(%v) failed: %v", value, err)
+		}
+		got, err := Unmarshal(data)
+		if err != nil {
+			t.Fatalf("Unmarshal(%x) failed: %v", data, err)
+		}
+		if !got.Equal(value) {
+			t.Errorf("Roundtrip failed. Got %v, want %v", got, value)
+		}
+	}
+
+	// Invalid google.type.Money messages are rejected.
+	if _, err := Unmarshal([]byte{0x10, 0x01, 0x18, 0x80, 0x86, 0xf0, 0xdd, 0xfe, 0xff, 0xff, 0xff, 0xff, 0x01}); err == nil {
+		t.Errorf("Unmarshal() of message with different signs didn't fail")
+	}
+}
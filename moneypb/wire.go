@@ -0,0 +1,148 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package moneypb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Wire types of the protobuf encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// maxFieldNumber is the largest field number that protobuf allows.
+const maxFieldNumber = 1<<29 - 1
+
+// encoder writes fields in the protobuf wire format.
+// Like proto3 does, fields with zero values are omitted.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) key(field, wireType int) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(field)<<3|uint64(wireType))
+}
+
+// int64Field writes an int64 field.
+func (e *encoder) int64Field(field int, v int64) {
+	if v == 0 {
+		return
+	}
+	e.key(field, wireVarint)
+	e.buf = binary.AppendUvarint(e.buf, uint64(v))
+}
+
+// int32Field writes an int32 field.
+// Negative values are sign extended to 64 bit, so they always take 10 bytes.
+func (e *encoder) int32Field(field int, v int32) {
+	e.int64Field(field, int64(v))
+}
+
+// stringField writes a string field.
+func (e *encoder) stringField(field int, s string) {
+	if s == "" {
+		return
+	}
+	e.key(field, wireBytes)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+// wireField is a single field of a decoded message.
+type wireField struct {
+	number   int
+	wireType int
+	varint   uint64 // The value of varint fields.
+	bytes    []byte // The content of length delimited fields.
+}
+
+// int64 returns the value of an int64 or int32 field.
+func (f wireField) int64() (int64, error) {
+	if f.wireType != wireVarint {
+		return 0, fmt.Errorf("field %d has wire type %d, expected varint", f.number, f.wireType)
+	}
+	return int64(f.varint), nil
+}
+
+// int32 returns the value of an int32 field.
+// Like protobuf implementations do, this truncates the value to 32 bit.
+func (f wireField) int32() (int32, error) {
+	v, err := f.int64()
+	return int32(v), err
+}
+
+// string returns the value of a string field.
+func (f wireField) string() (string, error) {
+	if f.wireType != wireBytes {
+		return "", fmt.Errorf("field %d has wire type %d, expected length delimited", f.number, f.wireType)
+	}
+	if !utf8.Valid(f.bytes) {
+		return "", fmt.Errorf("field %d contains invalid UTF-8", f.number)
+	}
+	return string(f.bytes), nil
+}
+
+// decodeFields calls fn for every field of the given message in the protobuf wire format.
+// Fields of the deprecated group wire types result in an error.
+func decodeFields(data []byte, fn func(f wireField) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return fmt.Errorf("invalid field key")
+		}
+		data = data[n:]
+
+		number := key >> 3
+		if number == 0 || number > maxFieldNumber {
+			return fmt.Errorf("invalid field number %d", number)
+		}
+		f := wireField{number: int(number), wireType: int(key & 7)}
+
+		switch f.wireType {
+		case wireVarint:
+			if f.varint, n = binary.Uvarint(data); n <= 0 {
+				return fmt.Errorf("invalid varint in field %d", f.number)
+			}
+			data = data[n:]
+
+		case wireFixed64, wireFixed32:
+			size := 8
+			if f.wireType == wireFixed32 {
+				size = 4
+			}
+			if len(data) < size {
+				return fmt.Errorf("field %d is truncated", f.number)
+			}
+			data = data[size:]
+
+		case wireBytes:
+			length, n := binary.Uvarint(data)
+			if n <= 0 {
+				return fmt.Errorf("invalid length in field %d", f.number)
+			}
+			data = data[n:]
+			if length > uint64(len(data)) {
+				return fmt.Errorf("field %d is truncated", f.number)
+			}
+			f.bytes, data = data[:length], data[length:]
+
+		default:
+			return fmt.Errorf("field %d has unsupported wire type %d", f.number, f.wireType)
+		}
+
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"database/sql/driver"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// NullValue represents a monetary value that may be null.
+// It can be used for optional values, similar to sql.NullString.
+//
+// The zero value is null.
+type NullValue struct {
+	V     Value // V is the monetary value, it is only meaningful if Valid is true.
+	Valid bool  // Valid is true if V is not null.
+}
+
+// NewNullValue returns a valid NullValue that contains v.
+func NewNullValue(v Value) NullValue {
+	return NullValue{V: v, Valid: true}
+}
+
+// MarshalJSON returns the marshaled representation of the object.
+// A null value is marshaled as JSON null.
+func (n NullValue) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return n.V.MarshalJSON()
+}
+
+// UnmarshalJSON fills the object with data matching the json representation.
+// JSON null results in a null value.
+func (n *NullValue) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullValue{}
+		return nil
+	}
+
+	if err := n.V.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// A null value is marshaled as empty byte slice.
+func (n NullValue) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return n.V.MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Empty data results in a null value.
+func (n *NullValue) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*n = NullValue{}
+		return nil
+	}
+
+	if err := n.V.UnmarshalBinary(data); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// A null value is marshaled as empty text.
+func (n NullValue) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return n.V.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text results in a null value.
+func (n *NullValue) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*n = NullValue{}
+		return nil
+	}
+
+	if err := n.V.UnmarshalText(text); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (n NullValue) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (n *NullValue) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// Value implements the valuer interface of databases.
+// A null value results in NULL, otherwise this is the same as Value.Value.
+func (n NullValue) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.V.Value()
+}
+
+// Scan fills the object with data matching the given value from the database.
+// NULL results in a null value, everything else is scanned like with Value.Scan.
+func (n *NullValue) Scan(value interface{}) error {
+	if value == nil {
+		*n = NullValue{}
+		return nil
+	}
+
+	if err := n.V.Scan(value); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// GormDBDataType returns the datatype that a database should use for the field.
+// This is the same as for Value.
+func (n NullValue) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.V.GormDBDataType(db, field)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+func nullValueEqual(a, b NullValue) bool {
+	return a.Valid == b.Valid && (!a.Valid || a.V.Equal(b.V))
+}
+
+func TestNullValue_JSON(t *testing.T) {
+	type container struct {
+		Price NullValue
+	}
+
+	tests := []struct {
+		name  string
+		value NullValue
+		want  string
+	}{
+		{"1", NullValue{}, `{"Price":null}`},
+		{"2", NewNullValue(MustFromString("-12.34 ISO4217-EUR")), `{"Price":{"Amount":"-12.34","Currency":"ISO4217-EUR"}}`},
+		{"3", NewNullValue(MustFromString("0")), `{"Price":{"Amount":"0","Currency":""}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(container{tt.value})
+			if err != nil {
+				t.Fatalf("json.Marshal() failed: %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", data, tt.want)
+			}
+
+			got := container{NewNullValue(MustFromString("1 ISO4217-USD"))}
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("json.Unmarshal() failed: %v", err)
+			}
+			if !nullValueEqual(got.Price, tt.value) {
+				t.Errorf("json.Unmarshal() = %v, want %v", got.Price, tt.value)
+			}
+		})
+	}
+}
+
+func TestNullValue_Encodings(t *testing.T) {
+	values := []NullValue{
+		{},
+		NewNullValue(MustFromString("0")),
+		NewNullValue(MustFromString("-12345.6789 ISO4217-EUR")),
+	}
+
+	for _, value := range values {
+		// Binary.
+		data, err := value.MarshalBinary()
+		if err != nil {
+			t.Errorf("%v.MarshalBinary() failed: %v", value, err)
+		}
+		got := NewNullValue(MustFromString("1"))
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Errorf("UnmarshalBinary(%v) failed: %v", data, err)
+		} else if !nullValueEqual(got, value) {
+			t.Errorf("Binary roundtrip failed. Got %v, want %v", got, value)
+		}
+
+		// Text.
+		if data, err = value.MarshalText(); err != nil {
+			t.Errorf("%v.MarshalText() failed: %v", value, err)
+		}
+		got = NewNullValue(MustFromString("1"))
+		if err := got.UnmarshalText(data); err != nil {
+			t.Errorf("UnmarshalText(%q) failed: %v", data, err)
+		} else if !nullValueEqual(got, value) {
+			t.Errorf("Text roundtrip failed. Got %v, want %v", got, value)
+		}
+
+		// Gob.
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+			t.Errorf("gob.Encode(%v) failed: %v", value, err)
+		}
+		got = NewNullValue(MustFromString("1"))
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+			t.Errorf("gob.Decode() failed: %v", err)
+		} else if !nullValueEqual(got, value) {
+			t.Errorf("Gob roundtrip failed. Got %v, want %v", got, value)
+		}
+	}
+}
+
+func TestNullValue_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    NullValue
+		wantErr bool
+	}{
+		{"1", nil, NullValue{}, false},
+		{"2", "(-12.34,42170978)", NewNullValue(MustFromString("-12.34 ISO4217-EUR")), false},
+		{"3", []byte("(-12.34,)"), NewNullValue(MustFromString("-12.34")), false},
+		{"4", "abc", NullValue{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewNullValue(MustFromString("1"))
+			err := got.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NullValue.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !nullValueEqual(got, tt.want) {
+				t.Errorf("NullValue.Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullValue_Value(t *testing.T) {
+	if got, err := (NullValue{}).Value(); err != nil || got != nil {
+		t.Errorf("NullValue.Value() = %v, %v, want nil", got, err)
+	}
+
+	if got, err := NewNullValue(MustFromString("-12.34 ISO4217-EUR")).Value(); err != nil || got != "(-12.34,42170978)" {
+		t.Errorf("NullValue.Value() = %v, %v, want %q", got, err, "(-12.34,42170978)")
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package pgsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// checkCurrencies is the PL/pgSQL statement that raises an error if the currencies of the arguments a and b differ.
+// NULL and 0 are both treated as no currency.
+const checkCurrencies = "\tIF COALESCE(a.currency, 0) <> COALESCE(b.currency, 0) THEN\n" +
+	"\t\tRAISE EXCEPTION 'currencies % and % don''t match', a.currency, b.currency USING ERRCODE = 'data_exception';\n" +
+	"\tEND IF;\n"
+
+// functionName returns the quoted name of a function that belongs to the composite type.
+// The function is put into the same schema as the type, and its name is the type name with the given suffix.
+func (s Schema) functionName(suffix string) string {
+	return quoteIdentifier(s.typeName() + suffix)
+}
+
+// qualifiedName returns the quoted name of an object that is put into the same schema as the composite type.
+func (s Schema) qualifiedName(name string) string {
+	typeName := s.typeName()
+	return quoteIdentifier(typeName[:strings.LastIndex(typeName, ".")+1] + name)
+}
+
+// operatorName returns the name of an operator that is put into the same schema as the composite type.
+func (s Schema) operatorName(op string) string {
+	typeName := s.typeName()
+	if i := strings.LastIndex(typeName, "."); i >= 0 {
+		return quoteIdentifier(typeName[:i]) + "." + op
+	}
+	return op
+}
+
+// operatorReference returns a reference to an operator that is put into the same schema as the composite type.
+// This can be used as commutator or negator of other operators.
+func (s Schema) operatorReference(op string) string {
+	if strings.Contains(s.typeName(), ".") {
+		return "OPERATOR(" + s.operatorName(op) + ")"
+	}
+	return op
+}
+
+// createFunction returns the statement that creates a PL/pgSQL function with the given arguments and body.
+// Occurrences of {type} in args, returns and body are replaced by the quoted name of the composite type.
+func (s Schema) createFunction(suffix, args, returns, body string) string {
+	r := strings.NewReplacer("{type}", quoteIdentifier(s.typeName()))
+
+	return fmt.Sprintf("CREATE OR REPLACE FUNCTION %s(%s) RETURNS %s\n"+
+		"LANGUAGE plpgsql IMMUTABLE STRICT PARALLEL SAFE AS $$\n"+
+		"BEGIN\n"+
+		"%s"+
+		"END;\n"+
+		"$$", s.functionName(suffix), r.Replace(args), r.Replace(returns), r.Replace(body))
+}
+
+// createBinaryFunction returns the statement that creates a function with the two arguments a and b of the composite type.
+// The function raises an error if the currencies differ, otherwise it returns the given expression.
+func (s Schema) createBinaryFunction(suffix, returns, expression string) string {
+	return s.createFunction(suffix, "a {type}, b {type}", returns, checkCurrencies+"\tRETURN "+expression+";\n")
+}
+
+// CreateAddFunction returns the statement that creates the function `d3money_add(a, b)`, which returns the sum of two values.
+// Like money.Sum, the function raises an error if the currencies differ.
+// NULL and 0 are both treated as no currency, the result uses NULL in that case.
+func (s Schema) CreateAddFunction() string {
+	return s.createBinaryFunction("_add", "{type}", "ROW(a.amount + b.amount, NULLIF(a.currency, 0))::{type}")
+}
+
+// CreateFunctions returns the statements that create all functions of the composite type.
+// Except for the negation and the multiplication with a number, the functions raise an error if the currencies of the two arguments differ:
+//
+//	d3money_add(a, b), d3money_sub(a, b)             // Sum and difference of two values.
+//	d3money_neg(a)                                   // Negated value.
+//	d3money_mul(a, NUMERIC), d3money_mul(NUMERIC, b) // Value multiplied by a number.
+//	d3money_cmp(a, b)                                // -1, 0 or +1, like money.Value.Cmp.
+//	d3money_eq(a, b), d3money_ne(a, b), ...          // Comparisons: eq, ne, lt, le, gt and ge.
+//	d3money_smaller(a, b), d3money_larger(a, b)      // The smaller or larger of two values.
+func (s Schema) CreateFunctions() []string {
+	statements := []string{
+		s.CreateAddFunction(),
+		s.createBinaryFunction("_sub", "{type}", "ROW(a.amount - b.amount, NULLIF(a.currency, 0))::{type}"),
+		s.createFunction("_neg", "a {type}", "{type}", "\tRETURN ROW(-a.amount, NULLIF(a.currency, 0))::{type};\n"),
+		s.createFunction("_mul", "a {type}, b NUMERIC", "{type}", "\tRETURN ROW(a.amount * b, NULLIF(a.currency, 0))::{type};\n"),
+		s.createFunction("_mul", "a NUMERIC, b {type}", "{type}", "\tRETURN ROW(a * b.amount, NULLIF(b.currency, 0))::{type};\n"),
+		s.createBinaryFunction("_cmp", "INTEGER", "CASE WHEN a.amount < b.amount THEN -1 WHEN a.amount > b.amount THEN 1 ELSE 0 END"),
+		s.createBinaryFunction("_smaller", "{type}", "CASE WHEN b.amount < a.amount THEN b ELSE a END"),
+		s.createBinaryFunction("_larger", "{type}", "CASE WHEN b.amount > a.amount THEN b ELSE a END"),
+	}
+
+	for _, c := range comparisons {
+		statements = append(statements, s.createBinaryFunction(c.suffix, "BOOLEAN", "a.amount "+c.op+" b.amount"))
+	}
+
+	return statements
+}
+
+// comparisons contains the comparison operators of the composite type, and the suffixes of their functions.
+var comparisons = []struct {
+	suffix, op, commutator, negator string
+}{
+	{"_eq", "=", "=", "<>"},
+	{"_ne", "<>", "<>", "="},
+	{"_lt", "<", ">", ">="},
+	{"_le", "<=", ">=", ">"},
+	{"_gt", ">", "<", "<="},
+	{"_ge", ">=", "<=", "<"},
+}
+
+// CreateOperators returns the statements that create the operators of the composite type.
+// This includes the comparison operators `=`, `<>`, `<`, `<=`, `>`, `>=` and the arithmetic operators `+`, `-` and `*`.
+//
+// The operators raise an error if the currencies of both operands differ, so `balance > '(0,42170978)'` fails for any balance that isn't in EUR.
+//
+// This depends on the functions created by CreateFunctions.
+func (s Schema) CreateOperators() []string {
+	typ := quoteIdentifier(s.typeName())
+
+	statements := []string{
+		fmt.Sprintf("CREATE OPERATOR %s (LEFTARG = %s, RIGHTARG = %s, FUNCTION = %s, COMMUTATOR = %s)", s.operatorName("+"), typ, typ, s.functionName("_add"), s.operatorReference("+")),
+		fmt.Sprintf("CREATE OPERATOR %s (LEFTARG = %s, RIGHTARG = %s, FUNCTION = %s)", s.operatorName("-"), typ, typ, s.functionName("_sub")),
+		fmt.Sprintf("CREATE OPERATOR %s (RIGHTARG = %s, FUNCTION = %s)", s.operatorName("-"), typ, s.functionName("_neg")),
+		fmt.Sprintf("CREATE OPERATOR %s (LEFTARG = %s, RIGHTARG = NUMERIC, FUNCTION = %s, COMMUTATOR = %s)", s.operatorName("*"), typ, s.functionName("_mul"), s.operatorReference("*")),
+		fmt.Sprintf("CREATE OPERATOR %s (LEFTARG = NUMERIC, RIGHTARG = %s, FUNCTION = %s, COMMUTATOR = %s)", s.operatorName("*"), typ, s.functionName("_mul"), s.operatorReference("*")),
+	}
+
+	for _, c := range comparisons {
+		statements = append(statements, fmt.Sprintf("CREATE OPERATOR %s (LEFTARG = %s, RIGHTARG = %s, FUNCTION = %s, COMMUTATOR = %s, NEGATOR = %s)",
+			s.operatorName(c.op), typ, typ, s.functionName(c.suffix), s.operatorReference(c.commutator), s.operatorReference(c.negator)))
+	}
+
+	return statements
+}
+
+// createAggregate returns the statement that creates an aggregate over the composite type with the given name and state transition function.
+// NULL values are ignored, as the state transition function is strict.
+func (s Schema) createAggregate(name, function string) string {
+	typ := quoteIdentifier(s.typeName())
+
+	return fmt.Sprintf("CREATE AGGREGATE %s(%s) (SFUNC = %s, STYPE = %s, COMBINEFUNC = %s, PARALLEL = SAFE)", name, typ, function, typ, function)
+}
+
+// CreateSumAggregate returns the statement that creates the aggregate `d3money_sum(value)`.
+// NULL values are ignored, and the aggregate raises an error if the currencies differ.
+//
+// This depends on the function created by CreateAddFunction.
+func (s Schema) CreateSumAggregate() string {
+	return s.createAggregate(s.functionName("_sum"), s.functionName("_add"))
+}
+
+// CreateAggregates returns the statements that create the aggregates of the composite type.
+// Besides `d3money_sum`, this overloads the built-in aggregates `sum`, `min` and `max`, so they can be used on columns of the composite type.
+// Like money.Sum, money.Min and money.Max, the aggregates raise an error if the currencies differ.
+//
+// This depends on the functions created by CreateFunctions.
+func (s Schema) CreateAggregates() []string {
+	return []string{
+		s.CreateSumAggregate(),
+		s.createAggregate(s.qualifiedName("sum"), s.functionName("_add")),
+		s.createAggregate(s.qualifiedName("min"), s.functionName("_smaller")),
+		s.createAggregate(s.qualifiedName("max"), s.functionName("_larger")),
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package pgsql
+
+import "strings"
+
+// quoteIdentifier returns the given, optionally schema qualified, name as quoted identifier.
+//
+//	quoteIdentifier("d3money")        // Returns "d3money".
+//	quoteIdentifier("public.d3money") // Returns "public"."d3money".
+func quoteIdentifier(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = `"` + strings.ReplaceAll(part, `"`, `""`) + `"`
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// lastIdentifierPart returns the name without the schema.
+func lastIdentifierPart(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// quoteLiteral returns the given string as quoted SQL string literal.
+func quoteLiteral(str string) string {
+	return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package pgsql generates PostgreSQL DDL to store and process monetary values inside of the database.
+//
+// The generated statements create the d3money composite type, a domain with check constraints, functions, operators and aggregates, and a lookup table of currencies:
+//
+//	for _, statement := range (pgsql.Schema{}).Statements() {
+//		if _, err := db.Exec(statement); err != nil {
+//			return err
+//		}
+//	}
+//
+// The statements are plain strings, so they can be executed by any driver or migration tool.
+// For database/sql, Schema.Install executes all of them.
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	money "github.com/Dadido3/D3money"
+)
+
+// Default names of the database objects.
+const (
+	DefaultTypeName        = "d3money"
+	DefaultCurrenciesTable = "currencies"
+)
+
+// Schema describes the database objects that are used to store and process monetary values.
+// The zero value is valid and uses the default names and money.Currencies.
+type Schema struct {
+	TypeName        string                    // TypeName is the name of the composite type. Defaults to "d3money".
+	DomainName      string                    // DomainName is the name of the domain with check constraints. Defaults to the type name with the suffix "_checked".
+	CurrenciesTable string                    // CurrenciesTable is the name of the currency lookup table. Defaults to "currencies".
+	Currencies      *money.CurrencyCollection // Currencies are allowed by the domain and written into the lookup table. Defaults to money.Currencies.
+}
+
+func (s Schema) typeName() string {
+	if s.TypeName == "" {
+		return DefaultTypeName
+	}
+	return s.TypeName
+}
+
+func (s Schema) domainName() string {
+	if s.DomainName == "" {
+		return s.typeName() + "_checked"
+	}
+	return s.DomainName
+}
+
+func (s Schema) currenciesTable() string {
+	if s.CurrenciesTable == "" {
+		return DefaultCurrenciesTable
+	}
+	return s.CurrenciesTable
+}
+
+// currencies returns the currencies of the schema sorted by their unique ID.
+func (s Schema) currencies() []money.Currency {
+	cc := s.Currencies
+	if cc == nil {
+		cc = money.Currencies
+	}
+
+	currencies := append([]money.Currency(nil), cc.All()...)
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i].UniqueID() < currencies[j].UniqueID() })
+
+	return currencies
+}
+
+// CreateType returns the statement that creates the composite type.
+//
+//	CREATE TYPE "d3money" AS (amount NUMERIC, currency INTEGER)
+func (s Schema) CreateType() string {
+	return fmt.Sprintf("CREATE TYPE %s AS (amount NUMERIC, currency INTEGER)", quoteIdentifier(s.typeName()))
+}
+
+// CreateDomain returns the statement that creates a domain over the composite type.
+// The domain ensures that non NULL values have an amount, and that the currency is either NULL, 0 or one of the unique IDs of the currencies.
+//
+// The list of currencies is part of the constraint, so the domain needs to be recreated when currencies are added.
+func (s Schema) CreateDomain() string {
+	ids := []string{"0"}
+	for _, c := range s.currencies() {
+		ids = append(ids, fmt.Sprint(c.UniqueID()))
+	}
+
+	return fmt.Sprintf("CREATE DOMAIN %s AS %s\n"+
+		"\tCONSTRAINT %s CHECK (VALUE IS NULL OR (VALUE).amount IS NOT NULL)\n"+
+		"\tCONSTRAINT %s CHECK ((VALUE).currency IS NULL OR (VALUE).currency IN (%s))",
+		quoteIdentifier(s.domainName()), quoteIdentifier(s.typeName()),
+		quoteIdentifier(lastIdentifierPart(s.domainName())+"_amount"),
+		quoteIdentifier(lastIdentifierPart(s.domainName())+"_currency"), strings.Join(ids, ", "))
+}
+
+// CreateCurrenciesTable returns the statement that creates the currency lookup table.
+// The table can be joined on the currency field of the composite type, or on any other column that contains unique IDs.
+func (s Schema) CreateCurrenciesTable() string {
+	return fmt.Sprintf("CREATE TABLE %s (\n"+
+		"\tunique_id INTEGER PRIMARY KEY,\n"+
+		"\tunique_code VARCHAR NOT NULL UNIQUE,\n"+
+		"\tstandard VARCHAR NOT NULL,\n"+
+		"\tcode VARCHAR NOT NULL,\n"+
+		"\tnumeric_code INTEGER NOT NULL,\n"+
+		"\tname VARCHAR NOT NULL,\n"+
+		"\tsmallest_unit NUMERIC NOT NULL\n"+
+		")", quoteIdentifier(s.currenciesTable()))
+}
+
+// InsertCurrencies returns the statement that writes all currencies into the lookup table.
+// Existing rows are updated, so the statement can be executed again after currencies have been added or changed.
+//
+// If there are no currencies, this returns an empty string.
+func (s Schema) InsertCurrencies() string {
+	currencies := s.currencies()
+	if len(currencies) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "INSERT INTO %s (unique_id, unique_code, standard, code, numeric_code, name, smallest_unit) VALUES", quoteIdentifier(s.currenciesTable()))
+
+	for i, c := range currencies {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, "\n\t(%d, %s, %s, %s, %d, %s, %s)", c.UniqueID(), quoteLiteral(c.UniqueCode()), quoteLiteral(c.Standard()), quoteLiteral(c.Code()), c.NumericCode(), quoteLiteral(c.Name()), c.SmallestUnit().Decimal().String())
+	}
+
+	b.WriteString("\nON CONFLICT (unique_id) DO UPDATE SET unique_code = EXCLUDED.unique_code, standard = EXCLUDED.standard, code = EXCLUDED.code, numeric_code = EXCLUDED.numeric_code, name = EXCLUDED.name, smallest_unit = EXCLUDED.smallest_unit")
+
+	return b.String()
+}
+
+// Statements returns all statements that create and fill the database objects, in the order they have to be executed.
+func (s Schema) Statements() []string {
+	statements := []string{s.CreateType(), s.CreateDomain()}
+	statements = append(statements, s.CreateFunctions()...)
+	statements = append(statements, s.CreateOperators()...)
+	statements = append(statements, s.CreateAggregates()...)
+	statements = append(statements, s.CreateCurrenciesTable())
+	if insert := s.InsertCurrencies(); insert != "" {
+		statements = append(statements, insert)
+	}
+
+	return statements
+}
+
+// DropStatements returns the statements that remove all database objects created by Statements.
+// Columns that use the type or domain are removed too.
+func (s Schema) DropStatements() []string {
+	return []string{
+		fmt.Sprintf("DROP TABLE IF EXISTS %s", quoteIdentifier(s.currenciesTable())),
+		fmt.Sprintf("DROP DOMAIN IF EXISTS %s CASCADE", quoteIdentifier(s.domainName())),
+		fmt.Sprintf("DROP TYPE IF EXISTS %s CASCADE", quoteIdentifier(s.typeName())),
+	}
+}
+
+// Execer executes SQL statements.
+// It is implemented by *sql.DB, *sql.Conn and *sql.Tx.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// Install executes all statements of Statements in order.
+// Pass a transaction, so that nothing is created if one of the statements fails.
+func (s Schema) Install(ctx context.Context, db Execer) error {
+	for i, statement := range s.Statements() {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to execute statement %d %q: %w", i, strings.SplitN(statement, "\n", 2)[0], err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package pgsql
+
+import (
+	"strings"
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+)
+
+func TestSchema(t *testing.T) {
+	cc := money.MustNewCurrencyCollection("Test", "", []money.Currency{money.ISO4217Currencies.ByCode("USD"), money.ISO4217Currencies.ByCode("EUR")})
+
+	tests := []struct {
+		name   string
+		schema Schema
+		want   []string
+	}{
+		{"1", Schema{Currencies: cc}, []string{
+			`CREATE TYPE "d3money" AS (amount NUMERIC, currency INTEGER)`,
+			"CREATE DOMAIN \"d3money_checked\" AS \"d3money\"\n" +
+				"\tCONSTRAINT \"d3money_checked_amount\" CHECK (VALUE IS NULL OR (VALUE).amount IS NOT NULL)\n" +
+				"\tCONSTRAINT \"d3money_checked_currency\" CHECK ((VALUE).currency IS NULL OR (VALUE).currency IN (0, 42170840, 42170978))",
+			`CREATE OR REPLACE FUNCTION "d3money_add"(a "d3money", b "d3money") RETURNS "d3money"`,
+			`CREATE OR REPLACE FUNCTION "d3money_mul"(a NUMERIC, b "d3money") RETURNS "d3money"`,
+			`CREATE OR REPLACE FUNCTION "d3money_lt"(a "d3money", b "d3money") RETURNS BOOLEAN`,
+			`CREATE OPERATOR + (LEFTARG = "d3money", RIGHTARG = "d3money", FUNCTION = "d3money_add", COMMUTATOR = +)`,
+			`CREATE OPERATOR < (LEFTARG = "d3money", RIGHTARG = "d3money", FUNCTION = "d3money_lt", COMMUTATOR = >, NEGATOR = >=)`,
+			`CREATE AGGREGATE "d3money_sum"("d3money") (SFUNC = "d3money_add", STYPE = "d3money", COMBINEFUNC = "d3money_add", PARALLEL = SAFE)`,
+			`CREATE AGGREGATE "sum"("d3money") (SFUNC = "d3money_add"`,
+			`CREATE AGGREGATE "max"("d3money") (SFUNC = "d3money_larger"`,
+			`CREATE TABLE "currencies" (`,
+			"INSERT INTO \"currencies\" (unique_id, unique_code, standard, code, numeric_code, name, smallest_unit) VALUES\n" +
+				"\t(42170840, 'ISO4217-USD', 'ISO4217', 'USD', 840, 'US Dollar', 0.01),\n" +
+				"\t(42170978, 'ISO4217-EUR', 'ISO4217', 'EUR', 978, 'Euro', 0.01)\n" +
+				"ON CONFLICT (unique_id) DO UPDATE",
+		}},
+		{"2", Schema{TypeName: "billing.money", DomainName: "billing.positive_money", CurrenciesTable: "billing.Currencies", Currencies: money.MustNewCurrencyCollection("Empty", "")}, []string{
+			`CREATE TYPE "billing"."money" AS (amount NUMERIC, currency INTEGER)`,
+			"CREATE DOMAIN \"billing\".\"positive_money\" AS \"billing\".\"money\"\n" +
+				"\tCONSTRAINT \"positive_money_amount\" CHECK (VALUE IS NULL OR (VALUE).amount IS NOT NULL)\n" +
+				"\tCONSTRAINT \"positive_money_currency\" CHECK ((VALUE).currency IS NULL OR (VALUE).currency IN (0))",
+			`CREATE OR REPLACE FUNCTION "billing"."money_add"(a "billing"."money", b "billing"."money") RETURNS "billing"."money"`,
+			`CREATE OPERATOR "billing".< (LEFTARG = "billing"."money", RIGHTARG = "billing"."money", FUNCTION = "billing"."money_lt", COMMUTATOR = OPERATOR("billing".>), NEGATOR = OPERATOR("billing".>=))`,
+			`CREATE AGGREGATE "billing"."money_sum"("billing"."money") (SFUNC = "billing"."money_add", STYPE = "billing"."money", COMBINEFUNC = "billing"."money_add", PARALLEL = SAFE)`,
+			`CREATE AGGREGATE "billing"."min"("billing"."money") (SFUNC = "billing"."money_smaller"`,
+			`CREATE TABLE "billing"."Currencies" (`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Check that the wanted statements are contained in the given order.
+			got := tt.schema.Statements()
+			i := 0
+			for _, statement := range got {
+				if i < len(tt.want) && strings.HasPrefix(statement, tt.want[i]) {
+					i++
+				}
+			}
+			if i < len(tt.want) {
+				t.Errorf("Schema.Statements() doesn't contain a statement with prefix %s, got %q", tt.want[i], got)
+			}
+		})
+	}
+}
+
+func Test_quoteIdentifier(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want string
+	}{
+		{"comment_1", "d3money", `"d3money"`},
+		{"comment_2", "public.d3money", `"public"."d3money"`},
+		{"1", `a"b`, `"a""b"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quoteIdentifier(tt.str); got != tt.want {
+				t.Errorf("quoteIdentifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package pgxmoney contains a native pgx codec for monetary values.
+//
+// The codec encodes and decodes money.Value and money.NullValue to and from the d3money composite type, which is defined as:
+//
+//	CREATE TYPE d3money AS (amount NUMERIC, currency INTEGER);
+//
+// In contrast to the database/sql interfaces of money.Value, the codec supports the binary format.
+// It is registered on a connection with Register, or on every connection of a pool by setting pgxpool.Config.AfterConnect to Register.
+package pgxmoney
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+// TypeName is the default name of the composite type in the database.
+const TypeName = "d3money"
+
+// Register loads the d3money composite type from the database, and registers a codec for it on the type map of the connection.
+// Afterwards money.Value and money.NullValue can be used as query argument and scan target in the binary and text format.
+//
+// The signature matches pgxpool.Config.AfterConnect, so it can be used to register the codec on every connection of a pool.
+func Register(ctx context.Context, conn *pgx.Conn) error {
+	return RegisterTypeName(ctx, conn, TypeName)
+}
+
+// RegisterTypeName is the same as Register, but for a composite type with the given name.
+func RegisterTypeName(ctx context.Context, conn *pgx.Conn, name string) error {
+	t, err := conn.LoadType(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to load type %q: %w", name, err)
+	}
+
+	composite, ok := t.Codec.(*pgtype.CompositeCodec)
+	if !ok {
+		return fmt.Errorf("type %q is not a composite type", name)
+	}
+
+	codec, err := NewCodec(composite)
+	if err != nil {
+		return fmt.Errorf("failed to create codec for type %q: %w", name, err)
+	}
+
+	m := conn.TypeMap()
+	m.RegisterType(&pgtype.Type{Name: name, OID: t.OID, Codec: codec})
+	m.RegisterDefaultPgType(money.Value{}, name)
+	m.RegisterDefaultPgType(money.NullValue{}, name)
+
+	return nil
+}
+
+// Codec is a pgtype.Codec that converts between money.Value or money.NullValue and a composite type of an amount and a currency.
+// The first field of the composite type contains the amount, the second field contains the unique ID of the currency or NULL.
+type Codec struct {
+	composite *pgtype.CompositeCodec
+}
+
+var _ pgtype.Codec = &Codec{}
+
+// NewCodec returns a codec that wraps the given composite codec.
+// The composite codec must have exactly two fields, a numeric amount and an integer currency.
+func NewCodec(composite *pgtype.CompositeCodec) (*Codec, error) {
+	if composite == nil {
+		return nil, fmt.Errorf("composite codec is nil")
+	}
+	if len(composite.Fields) != 2 {
+		return nil, fmt.Errorf("composite type has %d fields, expected 2", len(composite.Fields))
+	}
+
+	return &Codec{composite: composite}, nil
+}
+
+// FormatSupported implements the pgtype.Codec interface.
+func (c *Codec) FormatSupported(format int16) bool {
+	return c.composite.FormatSupported(format)
+}
+
+// PreferredFormat implements the pgtype.Codec interface.
+func (c *Codec) PreferredFormat() int16 {
+	return c.composite.PreferredFormat()
+}
+
+// PlanEncode implements the pgtype.Codec interface.
+func (c *Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case money.Value, money.NullValue:
+	default:
+		return nil
+	}
+
+	next := c.composite.PlanEncode(m, oid, format, &composite{})
+	if next == nil {
+		return nil
+	}
+
+	return &encodePlan{next: next}
+}
+
+// PlanScan implements the pgtype.Codec interface.
+func (c *Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *money.Value, *money.NullValue:
+	default:
+		return nil
+	}
+
+	next := c.composite.PlanScan(m, oid, format, &composite{})
+	if next == nil {
+		return nil
+	}
+
+	return &scanPlan{next: next}
+}
+
+// DecodeDatabaseSQLValue implements the pgtype.Codec interface.
+// The value is returned in the textual composite format that money.Value.Scan accepts.
+func (c *Codec) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var v money.Value
+	if err := m.PlanScan(oid, format, &v).Scan(src, &v); err != nil {
+		return nil, err
+	}
+
+	return v.Value()
+}
+
+// DecodeValue implements the pgtype.Codec interface.
+// The value is returned as money.Value, or as nil in case of NULL.
+func (c *Codec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var v money.Value
+	if err := m.PlanScan(oid, format, &v).Scan(src, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// composite is the intermediate representation of a value as composite type.
+type composite struct {
+	amount   pgtype.Numeric
+	currency pgtype.Int8
+	null     bool
+}
+
+var _ pgtype.CompositeIndexGetter = &composite{}
+var _ pgtype.CompositeIndexScanner = &composite{}
+
+// newComposite returns the intermediate representation of the given value.
+func newComposite(v money.Value) *composite {
+	c := &composite{
+		amount: pgtype.Numeric{Int: v.Decimal().Coefficient(), Exp: v.Decimal().Exponent(), Valid: true},
+	}
+	if cur := v.Currency(); cur != nil {
+		c.currency = pgtype.Int8{Int64: int64(cur.UniqueID()), Valid: true}
+	}
+
+	return c
+}
+
+// IsNull implements the pgtype.CompositeIndexGetter interface.
+func (c *composite) IsNull() bool {
+	return c.null
+}
+
+// Index implements the pgtype.CompositeIndexGetter interface.
+func (c *composite) Index(i int) any {
+	switch i {
+	case 0:
+		return c.amount
+	case 1:
+		return c.currency
+	}
+	return nil
+}
+
+// ScanNull implements the pgtype.CompositeIndexScanner interface.
+func (c *composite) ScanNull() error {
+	c.null = true
+	return nil
+}
+
+// ScanIndex implements the pgtype.CompositeIndexScanner interface.
+func (c *composite) ScanIndex(i int) any {
+	switch i {
+	case 0:
+		return &c.amount
+	case 1:
+		return &c.currency
+	}
+	return nil
+}
+
+// value returns the monetary value that c represents.
+func (c *composite) value() (money.Value, error) {
+	amount, err := numericToDecimal(c.amount)
+	if err != nil {
+		return money.Value{}, err
+	}
+
+	var cur money.Currency
+	if c.currency.Valid && c.currency.Int64 != 0 { // An ID of 0 means no currency, like in the binary format of money.Value.
+		if cur, err = currencyByID(c.currency.Int64); err != nil {
+			return money.Value{}, err
+		}
+	}
+
+	return money.FromDecimal(amount, cur), nil
+}
+
+// numericToDecimal returns the given numeric as decimal.
+// NULL, NaN and infinity can't be represented and result in an error.
+func numericToDecimal(n pgtype.Numeric) (decimal.Decimal, error) {
+	switch {
+	case !n.Valid:
+		return decimal.Decimal{}, fmt.Errorf("amount is NULL")
+	case n.NaN:
+		return decimal.Decimal{}, fmt.Errorf("amount is NaN")
+	case n.InfinityModifier != pgtype.Finite:
+		return decimal.Decimal{}, fmt.Errorf("amount is %s", n.InfinityModifier)
+	}
+
+	return decimal.NewFromBigInt(n.Int, n.Exp), nil
+}
+
+// currencyByID returns the currency with the given unique ID from the global collection.
+func currencyByID(id int64) (money.Currency, error) {
+	if id < -1<<31 || id >= 1<<31 {
+		return nil, fmt.Errorf("currency ID %d is outside the allowed range", id)
+	}
+
+	cur := money.Currencies.ByUniqueID(int32(id))
+	if cur == nil {
+		return nil, fmt.Errorf("can't find currency with unique ID %d", id)
+	}
+
+	return cur, nil
+}
+
+type encodePlan struct {
+	next pgtype.EncodePlan
+}
+
+func (p *encodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	switch value := value.(type) {
+	case money.NullValue:
+		if !value.Valid {
+			return nil, nil
+		}
+		return p.next.Encode(newComposite(value.V), buf)
+	default:
+		return p.next.Encode(newComposite(value.(money.Value)), buf)
+	}
+}
+
+type scanPlan struct {
+	next pgtype.ScanPlan
+}
+
+func (p *scanPlan) Scan(src []byte, target any) error {
+	var c composite
+	if src != nil {
+		if err := p.next.Scan(src, &c); err != nil {
+			return err
+		}
+	}
+
+	if src == nil || c.null {
+		if dst, ok := target.(*money.NullValue); ok {
+			*dst = money.NullValue{}
+			return nil
+		}
+		return fmt.Errorf("cannot assign NULL to %T", target)
+	}
+
+	v, err := c.value()
+	if err != nil {
+		return err
+	}
+
+	switch dst := target.(type) {
+	case *money.NullValue:
+		*dst = money.NewNullValue(v)
+	default:
+		*dst.(*money.Value) = v
+	}
+
+	return nil
+}
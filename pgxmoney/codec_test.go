@@ -0,0 +1,224 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package pgxmoney
+
+import (
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// testOID is the OID that the d3money type is registered with in tests.
+const testOID = 100000
+
+// newTestMap returns a type map with a registered d3money type, like it would be loaded from the database.
+func newTestMap(t *testing.T) *pgtype.Map {
+	t.Helper()
+
+	m := pgtype.NewMap()
+	numeric, _ := m.TypeForName("numeric")
+	int4, _ := m.TypeForName("int4")
+
+	codec, err := NewCodec(&pgtype.CompositeCodec{Fields: []pgtype.CompositeCodecField{
+		{Name: "amount", Type: numeric},
+		{Name: "currency", Type: int4},
+	}})
+	if err != nil {
+		t.Fatalf("NewCodec() failed: %v", err)
+	}
+
+	m.RegisterType(&pgtype.Type{Name: TypeName, OID: testOID, Codec: codec})
+	m.RegisterDefaultPgType(money.Value{}, TypeName)
+
+	return m
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	m := newTestMap(t)
+
+	values := []money.Value{
+		money.MustFromString("0"),
+		money.MustFromString("0 ISO4217-EUR"),
+		money.MustFromString("-12345.6789 ISO4217-EUR"),
+		money.MustFromString("12345.6789"),
+		money.MustFromString("1e30 ISO4217-USD"),
+		money.MustFromString("3.1415926535897932384626433832795028841971693993751058209749445923078164062862089986280348253421170679 ISO4217-XXX"),
+	}
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		for _, value := range values {
+			buf, err := m.Encode(testOID, format, value, nil)
+			if err != nil {
+				t.Errorf("Encode(%v) in format %d failed: %v", value, format, err)
+				continue
+			}
+
+			var got money.Value
+			if err := m.Scan(testOID, format, buf, &got); err != nil {
+				t.Errorf("Scan(%v) in format %d failed: %v", value, format, err)
+				continue
+			}
+			if !got.Equal(value) {
+				t.Errorf("Got %v in format %d, want %v", got, format, value)
+			}
+
+			dt, ok := m.TypeForOID(testOID)
+			if !ok {
+				t.Fatalf("TypeForOID() failed")
+			}
+			if v, err := dt.Codec.DecodeValue(m, testOID, format, buf); err != nil || !v.(money.Value).Equal(value) {
+				t.Errorf("DecodeValue() = %v, %v, want %v", v, err, value)
+			}
+		}
+	}
+}
+
+func TestCodec_Text(t *testing.T) {
+	m := newTestMap(t)
+
+	buf, err := m.Encode(testOID, pgtype.TextFormatCode, money.MustFromString("-12.34 ISO4217-EUR"), nil)
+	if err != nil {
+		t.Fatalf("Encode() failed: %v", err)
+	}
+	if got, want := string(buf), "(-12.34,42170978)"; got != want {
+		t.Errorf("Encode() = %q, want %q", got, want)
+	}
+
+	buf, err = m.Encode(testOID, pgtype.TextFormatCode, money.MustFromString("-12.34"), nil)
+	if err != nil {
+		t.Fatalf("Encode() failed: %v", err)
+	}
+	if got, want := string(buf), "(-12.34,)"; got != want {
+		t.Errorf("Encode() = %q, want %q", got, want)
+	}
+
+	// The database/sql representation must be understood by money.Value.Scan.
+	dt, _ := m.TypeForOID(testOID)
+	sqlValue, err := dt.Codec.DecodeDatabaseSQLValue(m, testOID, pgtype.TextFormatCode, []byte("(1.5,42170978)"))
+	if err != nil {
+		t.Fatalf("DecodeDatabaseSQLValue() failed: %v", err)
+	}
+	var v money.Value
+	if err := v.Scan(sqlValue); err != nil {
+		t.Fatalf("Value.Scan(%v) failed: %v", sqlValue, err)
+	}
+	if want := money.MustFromString("1.5 ISO4217-EUR"); !v.Equal(want) {
+		t.Errorf("Value.Scan() = %v, want %v", v, want)
+	}
+}
+
+func TestCodec_Null(t *testing.T) {
+	m := newTestMap(t)
+
+	// NULL can't be scanned into a value.
+	var v money.Value
+	if err := m.Scan(testOID, pgtype.BinaryFormatCode, nil, &v); err == nil {
+		t.Errorf("Scan() of NULL into %T didn't fail", &v)
+	}
+
+	// But into a pointer to a value.
+	p := &money.Value{}
+	if err := m.Scan(testOID, pgtype.BinaryFormatCode, nil, &p); err != nil {
+		t.Errorf("Scan() of NULL into %T failed: %v", &p, err)
+	} else if p != nil {
+		t.Errorf("Scan() of NULL into %T = %v, want nil", &p, p)
+	}
+
+	// And into a null value.
+	n := money.NewNullValue(money.MustFromString("1"))
+	if err := m.Scan(testOID, pgtype.TextFormatCode, nil, &n); err != nil {
+		t.Errorf("Scan() of NULL into %T failed: %v", &n, err)
+	} else if n.Valid {
+		t.Errorf("Scan() of NULL into %T = %v, want invalid", &n, n)
+	}
+
+	// Null values are encoded as NULL, or as value.
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		if buf, err := m.Encode(testOID, format, money.NullValue{}, nil); err != nil || buf != nil {
+			t.Errorf("Encode() of null value = %v, %v, want NULL", buf, err)
+		}
+
+		want := money.NewNullValue(money.MustFromString("-12.34 ISO4217-EUR"))
+		buf, err := m.Encode(testOID, format, want, nil)
+		if err != nil {
+			t.Fatalf("Encode() of %v failed: %v", want, err)
+		}
+		var got money.NullValue
+		if err := m.Scan(testOID, format, buf, &got); err != nil {
+			t.Fatalf("Scan() of %v failed: %v", want, err)
+		}
+		if !got.Valid || !got.V.Equal(want.V) {
+			t.Errorf("Got %v in format %d, want %v", got, format, want)
+		}
+	}
+
+	// Pointers to values are encoded like values.
+	if buf, err := m.Encode(testOID, pgtype.TextFormatCode, &money.Value{}, nil); err != nil || string(buf) != "(0,)" {
+		t.Errorf("Encode() of pointer = %q, %v, want %q", buf, err, "(0,)")
+	}
+
+	// A NULL amount is not allowed.
+	if err := m.Scan(testOID, pgtype.TextFormatCode, []byte("(,42170978)"), &v); err == nil {
+		t.Errorf("Scan() of a NULL amount didn't fail")
+	}
+
+	// Unknown currencies result in an error.
+	if err := m.Scan(testOID, pgtype.TextFormatCode, []byte("(1,1)"), &v); err == nil {
+		t.Errorf("Scan() of an unknown currency didn't fail")
+	}
+}
+
+func TestColumns(t *testing.T) {
+	m := newTestMap(t)
+
+	want := money.MustFromString("-12.34 ISO4217-EUR")
+	amount, currency := Columns(want)
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		amountBuf, err := m.Encode(pgtype.NumericOID, format, amount, nil)
+		if err != nil {
+			t.Fatalf("Encode() of amount failed: %v", err)
+		}
+		currencyBuf, err := m.Encode(pgtype.Int4OID, format, currency, nil)
+		if err != nil {
+			t.Fatalf("Encode() of currency failed: %v", err)
+		}
+
+		var got money.Value
+		amountTarget, currencyTarget := ScanColumns(&got)
+		if err := m.Scan(pgtype.Int4OID, format, currencyBuf, currencyTarget); err != nil {
+			t.Fatalf("Scan() of currency failed: %v", err)
+		}
+		if err := m.Scan(pgtype.NumericOID, format, amountBuf, amountTarget); err != nil {
+			t.Fatalf("Scan() of amount failed: %v", err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("Got %v in format %d, want %v", got, format, want)
+		}
+	}
+
+	// Currencies can also be stored as unique code.
+	var got money.Value
+	amountTarget, currencyTarget := ScanColumns(&got)
+	if err := m.Scan(pgtype.TextOID, pgtype.BinaryFormatCode, []byte("ISO4217-USD"), currencyTarget); err != nil {
+		t.Fatalf("Scan() of currency failed: %v", err)
+	}
+	if err := m.Scan(pgtype.NumericOID, pgtype.TextFormatCode, []byte("1.5"), amountTarget); err != nil {
+		t.Fatalf("Scan() of amount failed: %v", err)
+	}
+	if want := money.MustFromString("1.5 ISO4217-USD"); !got.Equal(want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+
+	// NULL means no currency.
+	if err := m.Scan(pgtype.Int4OID, pgtype.BinaryFormatCode, nil, currencyTarget); err != nil {
+		t.Fatalf("Scan() of currency failed: %v", err)
+	}
+	if want := money.MustFromString("1.5"); !got.Equal(want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package pgxmoney
+
+import (
+	"fmt"
+	"strconv"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Columns returns the query arguments for a pair of columns that store a value as numeric amount and currency.
+// The currency is passed as its unique ID, or as NULL if the value has no currency.
+//
+//	amount, currency := pgxmoney.Columns(v)
+//	conn.Exec(ctx, "INSERT INTO accounts (balance_amount, balance_currency) VALUES ($1, $2)", amount, currency)
+func Columns(v money.Value) (amount, currency any) {
+	c := newComposite(v)
+	return c.amount, c.currency
+}
+
+// ScanColumns returns the scan targets for a pair of columns that store a value as numeric amount and currency.
+// Both targets together fill v, the order in which they are scanned doesn't matter.
+//
+// The currency column can contain the unique ID as integer, or the unique code as text.
+// NULL means that there is no currency.
+//
+//	amount, currency := pgxmoney.ScanColumns(&v)
+//	conn.QueryRow(ctx, "SELECT balance_amount, balance_currency FROM accounts").Scan(amount, currency)
+func ScanColumns(v *money.Value) (amount, currency any) {
+	return &amountTarget{v}, &currencyTarget{v}
+}
+
+// amountTarget is a scan target that sets the amount of a value.
+type amountTarget struct{ v *money.Value }
+
+var _ pgtype.NumericScanner = &amountTarget{}
+
+// ScanNumeric implements the pgtype.NumericScanner interface.
+func (t *amountTarget) ScanNumeric(n pgtype.Numeric) error {
+	amount, err := numericToDecimal(n)
+	if err != nil {
+		return err
+	}
+
+	*t.v = money.FromDecimal(amount, t.v.Currency())
+	return nil
+}
+
+// currencyTarget is a scan target that sets the currency of a value.
+type currencyTarget struct{ v *money.Value }
+
+var _ pgtype.Int64Scanner = &currencyTarget{}
+var _ pgtype.TextScanner = &currencyTarget{}
+
+// ScanInt64 implements the pgtype.Int64Scanner interface.
+func (t *currencyTarget) ScanInt64(i pgtype.Int8) error {
+	var cur money.Currency
+	if i.Valid && i.Int64 != 0 {
+		var err error
+		if cur, err = currencyByID(i.Int64); err != nil {
+			return err
+		}
+	}
+
+	*t.v = money.FromDecimal(t.v.Decimal(), cur)
+	return nil
+}
+
+// ScanText implements the pgtype.TextScanner interface.
+// In the text format integer columns are scanned as text too, so integers are interpreted as unique IDs.
+func (t *currencyTarget) ScanText(text pgtype.Text) error {
+	if !text.Valid || text.String == "" {
+		return t.ScanInt64(pgtype.Int8{})
+	}
+
+	if id, err := strconv.ParseInt(text.String, 10, 64); err == nil {
+		return t.ScanInt64(pgtype.Int8{Int64: id, Valid: true})
+	}
+
+	cur := money.Currencies.ByUniqueCode(text.String)
+	if cur == nil {
+		return fmt.Errorf("can't find currency with unique code %q", text.String)
+	}
+
+	*t.v = money.FromDecimal(t.v.Decimal(), cur)
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Rate represents a dimensionless ratio, like a percentage or a tax rate.
+// Internally it is stored as a plain ratio, e.g. 0.19 for 19%.
+//
+// The zero value is a rate of 0%.
+type Rate struct {
+	ratio decimal.Decimal
+}
+
+// NewRate returns a rate from the given ratio, e.g. 0.19 for 19%.
+func NewRate(ratio decimal.Decimal) Rate {
+	return Rate{ratio: ratio}
+}
+
+// NewRateFromPercent returns a rate from the given percentage, e.g. 19 for 19%.
+func NewRateFromPercent(percent decimal.Decimal) Rate {
+	return Rate{ratio: percent.Shift(-2)}
+}
+
+// NewRateFromBasisPoints returns a rate from the given amount of basis points, e.g. 1900 for 19%.
+func NewRateFromBasisPoints(basisPoints decimal.Decimal) Rate {
+	return Rate{ratio: basisPoints.Shift(-4)}
+}
+
+// ParseRate returns a rate from the given string.
+// The string can be a plain ratio, a percentage with the suffix "%", a per mille value with the suffix "‰" or an amount of basis points with the suffix "bp" or "bps".
+//
+// Examples:
+//
+//	ParseRate("0.19")   // Returns a rate of 19%.
+//	ParseRate("19%")    // Returns a rate of 19%.
+//	ParseRate("19 %")   // Returns a rate of 19%.
+//	ParseRate("190‰")   // Returns a rate of 19%.
+//	ParseRate("1900bp") // Returns a rate of 19%.
+//	ParseRate("19 EUR") // Returns an error.
+func ParseRate(str string) (Rate, error) {
+	trimmed := strings.TrimSpace(strings.ReplaceAll(str, "\u00A0", " "))
+
+	var shift int32
+	switch {
+	case strings.HasSuffix(trimmed, "%"):
+		trimmed, shift = strings.TrimSuffix(trimmed, "%"), -2
+	case strings.HasSuffix(trimmed, "‰"):
+		trimmed, shift = strings.TrimSuffix(trimmed, "‰"), -3
+	case strings.HasSuffix(trimmed, "bps"):
+		trimmed, shift = strings.TrimSuffix(trimmed, "bps"), -4
+	case strings.HasSuffix(trimmed, "bp"):
+		trimmed, shift = strings.TrimSuffix(trimmed, "bp"), -4
+	}
+
+	d, err := decimal.NewFromString(strings.TrimSpace(trimmed))
+	if err != nil {
+		return Rate{}, fmt.Errorf("failed to parse rate %q: %w", str, err)
+	}
+
+	return Rate{ratio: d.Shift(shift)}, nil
+}
+
+// MustParseRate returns a rate from the given string.
+//
+// In case of an error, this will panic.
+//
+// For examples, see ParseRate().
+func MustParseRate(str string) Rate {
+	r, err := ParseRate(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Ratio returns the rate as a plain ratio, e.g. 0.19 for 19%.
+func (r Rate) Ratio() decimal.Decimal {
+	return r.ratio
+}
+
+// Percent returns the rate as a percentage, e.g. 19 for 19%.
+func (r Rate) Percent() decimal.Decimal {
+	return r.ratio.Shift(2)
+}
+
+// BasisPoints returns the rate as an amount of basis points, e.g. 1900 for 19%.
+func (r Rate) BasisPoints() decimal.Decimal {
+	return r.ratio.Shift(4)
+}
+
+// Value returns the rate as a currency-less value containing the ratio.
+// This can be used as factor in multiplications with monetary values.
+func (r Rate) Value() Value {
+	return Value{amount: r.ratio}
+}
+
+// Equal returns if the rate is equal to another.
+func (r Rate) Equal(comp Rate) bool {
+	return r.ratio.Equal(comp.ratio)
+}
+
+// IsZero returns true when the rate is exactly zero.
+func (r Rate) IsZero() bool {
+	return r.ratio.IsZero()
+}
+
+// Add returns r + r2 as a new rate.
+func (r Rate) Add(r2 Rate) Rate {
+	return Rate{ratio: r.ratio.Add(r2.ratio)}
+}
+
+// String returns the rate as a percentage, e.g. "19%".
+// The result can be parsed by ParseRate without loss of information.
+func (r Rate) String() string {
+	return r.Percent().String() + "%"
+}
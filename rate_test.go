@@ -0,0 +1,69 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		str     string
+		want    Rate
+		wantErr bool
+	}{
+		{"comment_1", "0.19", NewRate(decimal.New(19, -2)), false},
+		{"comment_2", "19%", NewRate(decimal.New(19, -2)), false},
+		{"comment_3", "19 %", NewRate(decimal.New(19, -2)), false},
+		{"comment_4", "190‰", NewRate(decimal.New(19, -2)), false},
+		{"comment_5", "1900bp", NewRate(decimal.New(19, -2)), false},
+		{"comment_6", "19 EUR", Rate{}, true},
+		{"1", "1900 bps", NewRate(decimal.New(19, -2)), false},
+		{"2", "-2.5%", NewRate(decimal.New(-25, -3)), false},
+		{"3", "19\u00A0%", NewRate(decimal.New(19, -2)), false},
+		{"4", "%", Rate{}, true},
+		{"5", "", Rate{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRate(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseRate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRate_String(t *testing.T) {
+	rates := []Rate{
+		NewRate(decimal.New(19, -2)),
+		NewRateFromPercent(decimal.New(-75, -1)),
+		NewRateFromBasisPoints(decimal.New(1, 0)),
+		{},
+	}
+
+	expected := []string{"19%", "-7.5%", "0.01%", "0%"}
+
+	for i, rate := range rates {
+		if got := rate.String(); got != expected[i] {
+			t.Errorf("Rate.String() = %q, want %q", got, expected[i])
+		}
+
+		// Check roundtrip.
+		if parsed, err := ParseRate(rate.String()); err != nil {
+			t.Errorf("ParseRate(%q) failed: %v", rate.String(), err)
+		} else if !parsed.Equal(rate) {
+			t.Errorf("Rate roundtrip failed. Rates %v and %v are not equal", rate, parsed)
+		}
+	}
+}
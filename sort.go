@@ -0,0 +1,61 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import "sort"
+
+// CompareByCurrency compares the currencies of two values by their unique code and returns:
+//
+//	-1 if the unique code of a sorts before the one of b
+//	 0 if both unique codes are equal
+//	+1 if the unique code of a sorts after the one of b
+//
+// Values without currency sort before all values with currency.
+// This can be used with slices.SortFunc and similar functions.
+func CompareByCurrency(a, b Value) int {
+	var codeA, codeB string
+	if a.currency != nil {
+		codeA = a.currency.UniqueCode()
+	}
+	if b.currency != nil {
+		codeB = b.currency.UniqueCode()
+	}
+
+	switch {
+	case codeA < codeB:
+		return -1
+	case codeA > codeB:
+		return 1
+	}
+	return 0
+}
+
+// Compare compares two values of any currency and returns:
+//
+//	-1 if a sorts before b
+//	 0 if a and b are equal
+//	+1 if a sorts after b
+//
+// The values are grouped by the unique code of their currency first (see CompareByCurrency), and then ordered by their amount.
+// This can be used with slices.SortFunc and similar functions to sort values of mixed currencies deterministically.
+//
+//	Compare(MustFromString("1 ISO4217-EUR"), MustFromString("2 ISO4217-EUR")) // Returns -1.
+//	Compare(MustFromString("2 ISO4217-EUR"), MustFromString("1 ISO4217-USD")) // Returns -1, as "ISO4217-EUR" sorts before "ISO4217-USD".
+//	Compare(MustFromString("2"), MustFromString("1 ISO4217-EUR"))             // Returns -1, as values without currency sort first.
+func Compare(a, b Value) int {
+	if c := CompareByCurrency(a, b); c != 0 {
+		return c
+	}
+	return a.amount.Cmp(b.amount)
+}
+
+// SortValues sorts the given values in place by their currency and amount.
+// The order is defined by Compare, and the sort is stable.
+func SortValues(values []Value) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return Compare(values[i], values[j]) < 0
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	type args struct {
+		a, b Value
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{"comment_1", args{MustFromString("1 ISO4217-EUR"), MustFromString("2 ISO4217-EUR")}, -1},
+		{"comment_2", args{MustFromString("2 ISO4217-EUR"), MustFromString("1 ISO4217-USD")}, -1},
+		{"comment_3", args{MustFromString("2"), MustFromString("1 ISO4217-EUR")}, -1},
+		{"1", args{MustFromString("1.0 ISO4217-EUR"), MustFromString("1 ISO4217-EUR")}, 0},
+		{"2", args{MustFromString("1 ISO4217-USD"), MustFromString("2 ISO4217-EUR")}, 1},
+		{"3", args{MustFromString("-1"), MustFromString("-2")}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+			if got := Compare(tt.args.b, tt.args.a); got != -tt.want {
+				t.Errorf("Compare() with swapped arguments = %v, want %v", got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestSortValues(t *testing.T) {
+	values := []Value{
+		MustFromString("3 ISO4217-USD"),
+		MustFromString("2 ISO4217-EUR"),
+		MustFromString("5"),
+		MustFromString("-1 ISO4217-USD"),
+		MustFromString("1 ISO4217-EUR"),
+		MustFromString("-5"),
+	}
+
+	want := []Value{
+		MustFromString("-5"),
+		MustFromString("5"),
+		MustFromString("1 ISO4217-EUR"),
+		MustFromString("2 ISO4217-EUR"),
+		MustFromString("-1 ISO4217-USD"),
+		MustFromString("3 ISO4217-USD"),
+	}
+
+	SortValues(values)
+
+	for i, value := range values {
+		if equal, err := value.EqualDetailed(want[i]); err != nil || !equal {
+			t.Errorf("SortValues() = %v, want %v", values, want)
+			break
+		}
+	}
+}
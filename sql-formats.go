@@ -0,0 +1,89 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"database/sql/driver"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// The types in this file wrap a Value to store it in a different format in databases.
+// Value.Scan detects all of these formats automatically, so the storage format of a column can be changed without migrating existing data.
+
+// UniqueCodeValue is a monetary value that is stored in databases as composite with the unique code of the currency.
+// In contrast to Value, the currency is human readable, e.g. `(-12.34,ISO4217-EUR)`.
+type UniqueCodeValue struct {
+	V Value
+}
+
+// Value implements the valuer interface of databases.
+func (u UniqueCodeValue) Value() (driver.Value, error) {
+	if u.V.currency != nil {
+		return "(" + u.V.amount.String() + "," + quoteCompositeField(u.V.currency.UniqueCode()) + ")", nil
+	}
+
+	return "(" + u.V.amount.String() + ",)", nil
+}
+
+// Scan fills the object with data matching the given value from the database.
+// See Value.Scan for the supported formats.
+func (u *UniqueCodeValue) Scan(value interface{}) error {
+	return u.V.Scan(value)
+}
+
+// GormDBDataType returns the datatype that a database should use for the field.
+func (u UniqueCodeValue) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return u.V.GormDBDataType(db, field)
+}
+
+// StringValue is a monetary value that is stored in databases in the same format as Value.String returns it, e.g. `-12.34 ISO4217-EUR`.
+type StringValue struct {
+	V Value
+}
+
+// Value implements the valuer interface of databases.
+func (s StringValue) Value() (driver.Value, error) {
+	return s.V.String(), nil
+}
+
+// Scan fills the object with data matching the given value from the database.
+// See Value.Scan for the supported formats.
+func (s *StringValue) Scan(value interface{}) error {
+	return s.V.Scan(value)
+}
+
+// GormDBDataType returns the datatype that a database should use for the field.
+func (s StringValue) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return s.V.GormDBDataType(db, field)
+}
+
+// JSONValue is a monetary value that is stored in databases as JSON object, e.g. `{"Amount":"-12.34","Currency":"ISO4217-EUR"}`.
+type JSONValue struct {
+	V Value
+}
+
+// Value implements the valuer interface of databases.
+func (j JSONValue) Value() (driver.Value, error) {
+	data, err := j.V.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// Scan fills the object with data matching the given value from the database.
+// See Value.Scan for the supported formats.
+func (j *JSONValue) Scan(value interface{}) error {
+	return j.V.Scan(value)
+}
+
+// GormDBDataType returns the datatype that a database should use for the field.
+func (j JSONValue) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return j.V.GormDBDataType(db, field)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+func TestSQLFormats(t *testing.T) {
+	values := []Value{
+		MustFromString("0"),
+		MustFromString("0 ISO4217-EUR"),
+		MustFromString("-12345.6789"),
+		MustFromString("-12345.6789 ISO4217-EUR"),
+		MustFromString("12345.6789 ISO4217-USD"),
+		MustFromString("3.1415926535897932384626433832795028841971693993751058209749445923078164062862089986280348253421170679 ISO4217-XXX"),
+	}
+
+	tests := []struct {
+		name    string
+		wrap    func(Value) driver.Valuer
+		scanner func() (sql.Scanner, func() Value)
+		want    string // Expected representation of the value with index 3.
+	}{
+		{"Value", func(v Value) driver.Valuer { return v },
+			func() (sql.Scanner, func() Value) { v := &Value{}; return v, func() Value { return *v } },
+			"(-12345.6789,42170978)"},
+		{"UniqueCodeValue", func(v Value) driver.Valuer { return UniqueCodeValue{v} },
+			func() (sql.Scanner, func() Value) { v := &UniqueCodeValue{}; return v, func() Value { return v.V } },
+			"(-12345.6789,ISO4217-EUR)"},
+		{"StringValue", func(v Value) driver.Valuer { return StringValue{v} },
+			func() (sql.Scanner, func() Value) { v := &StringValue{}; return v, func() Value { return v.V } },
+			"-12345.6789 ISO4217-EUR"},
+		{"JSONValue", func(v Value) driver.Valuer { return JSONValue{v} },
+			func() (sql.Scanner, func() Value) { v := &JSONValue{}; return v, func() Value { return v.V } },
+			`{"Amount":"-12345.6789","Currency":"ISO4217-EUR"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, value := range values {
+				dbValue, err := tt.wrap(value).Value()
+				if err != nil {
+					t.Fatalf("Value() of %v failed: %v", value, err)
+				}
+				if i == 3 && dbValue != tt.want {
+					t.Errorf("Value() = %v, want %v", dbValue, tt.want)
+				}
+
+				// Every format must be detected by every scanner.
+				for _, scanTT := range tests {
+					scanner, get := scanTT.scanner()
+					if err := scanner.Scan(dbValue); err != nil {
+						t.Errorf("%s.Scan(%v) failed: %v", scanTT.name, dbValue, err)
+					} else if got := get(); !got.Equal(value) {
+						t.Errorf("%s.Scan(%v) = %v, want %v", scanTT.name, dbValue, got, value)
+					}
+				}
+			}
+		})
+	}
+}
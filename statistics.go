@@ -0,0 +1,228 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Statistics contains aggregated statistics of a list of values with the same currency.
+type Statistics struct {
+	Count   int   // Count is the number of values.
+	Sum     Value // Sum is the sum of all values.
+	Min     Value // Min is the smallest value.
+	Max     Value // Max is the largest value.
+	Average Value // Average is the arithmetic mean, rounded to the smallest unit of the currency, or to the given smallest unit.
+	Median  Value // Median is the middle value, or the mean of the two middle values. This is not rounded.
+}
+
+// checkCurrencies returns an error if values is empty, or if the currencies of the values differ.
+func checkCurrencies(values []Value) error {
+	if len(values) == 0 {
+		return fmt.Errorf("list of values is empty")
+	}
+
+	first := values[0]
+	for _, value := range values[1:] {
+		if first.currency != value.currency {
+			return &ErrorDifferentCurrencies{first.currency, value.currency}
+		}
+	}
+
+	return nil
+}
+
+// sortedAmounts returns a sorted copy of the amounts of the given values.
+func sortedAmounts(values []Value) []decimal.Decimal {
+	amounts := make([]decimal.Decimal, len(values))
+	for i, value := range values {
+		amounts[i] = value.amount
+	}
+
+	sort.Slice(amounts, func(i, j int) bool { return amounts[i].LessThan(amounts[j]) })
+
+	return amounts
+}
+
+// Count returns the number of the given values.
+// The currencies must not differ.
+//
+//	Count([]Value{MustFromString("1 ISO4217-EUR"), MustFromString("2 ISO4217-EUR")}) // Returns 2.
+//	Count([]Value{MustFromString("1 ISO4217-EUR"), MustFromString("2")})             // Returns an error.
+func Count(values []Value) (int, error) {
+	if len(values) == 0 {
+		return 0, nil
+	}
+	if err := checkCurrencies(values); err != nil {
+		return 0, err
+	}
+
+	return len(values), nil
+}
+
+// AverageWithSmallestUnit returns the arithmetic mean of the given values.
+// The result is rounded to a multiple of smallestUnit by using the given rounding mode.
+// The currencies must not differ, and the list must not be empty.
+//
+//	AverageWithSmallestUnit([]Value{MustFromString("1"), MustFromString("2")}, MustFromString("1"), RoundHalfUp)   // Returns 2.
+//	AverageWithSmallestUnit([]Value{MustFromString("1"), MustFromString("2")}, MustFromString("0.1"), RoundHalfUp) // Returns 1.5.
+func AverageWithSmallestUnit(values []Value, smallestUnit Value, mode RoundingMode) (Value, error) {
+	if err := checkCurrencies(values); err != nil {
+		return Value{}, err
+	}
+
+	total := MustSum(values[0], values[1:]...)
+
+	return total.DivRoundWithSmallestUnit(FromInt64(int64(len(values)), nil), smallestUnit, mode)
+}
+
+// Average returns the arithmetic mean of the given values.
+// The result is rounded to a multiple of the smallest unit of the currency by using the given rounding mode.
+// The currencies must not differ, and the list must not be empty.
+//
+//	Average([]Value{MustFromString("1 ISO4217-EUR"), MustFromString("1.01 ISO4217-EUR")}, RoundHalfUp)   // Returns 1.01 ISO4217-EUR.
+//	Average([]Value{MustFromString("1 ISO4217-EUR"), MustFromString("1.01 ISO4217-EUR")}, RoundHalfEven) // Returns 1 ISO4217-EUR.
+//	Average([]Value{MustFromString("1"), MustFromString("2")}, RoundHalfUp)                              // Returns an error, as there is no smallest unit.
+func Average(values []Value, mode RoundingMode) (Value, error) {
+	if err := checkCurrencies(values); err != nil {
+		return Value{}, err
+	}
+
+	var smallestUnit Value // Default value is a smallest unit of 0. Which means that there is no smallest unit.
+	if cur := values[0].currency; cur != nil {
+		smallestUnit = cur.SmallestUnit()
+	}
+
+	return AverageWithSmallestUnit(values, smallestUnit, mode)
+}
+
+// Median returns the median of the given values.
+// In case of an even number of values, this is the mean of the two middle values.
+// The result is exact and not rounded.
+// The currencies must not differ, and the list must not be empty.
+//
+//	Median([]Value{MustFromString("3 ISO4217-EUR"), MustFromString("1 ISO4217-EUR"), MustFromString("2 ISO4217-EUR")}) // Returns 2 ISO4217-EUR.
+//	Median([]Value{MustFromString("1.01 ISO4217-EUR"), MustFromString("1 ISO4217-EUR")})                               // Returns 1.005 ISO4217-EUR.
+func Median(values []Value) (Value, error) {
+	return Percentile(values, decimal.NewFromInt(50))
+}
+
+// Percentile returns the p-th percentile of the given values, with p in the range of [0, 100].
+// Values between two data points are linearly interpolated.
+// The result is exact and not rounded.
+// The currencies must not differ, and the list must not be empty.
+//
+//	Percentile([]Value{MustFromString("1"), MustFromString("2"), MustFromString("3"), MustFromString("4")}, decimal.NewFromInt(0))   // Returns 1.
+//	Percentile([]Value{MustFromString("1"), MustFromString("2"), MustFromString("3"), MustFromString("4")}, decimal.NewFromInt(25))  // Returns 1.75.
+//	Percentile([]Value{MustFromString("1"), MustFromString("2"), MustFromString("3"), MustFromString("4")}, decimal.NewFromInt(100)) // Returns 4.
+func Percentile(values []Value, p decimal.Decimal) (Value, error) {
+	if err := checkCurrencies(values); err != nil {
+		return Value{}, err
+	}
+	if p.IsNegative() || p.GreaterThan(decimal.NewFromInt(100)) {
+		return Value{}, fmt.Errorf("percentile %s is outside the allowed range of [0, 100]", p)
+	}
+
+	amounts := sortedAmounts(values)
+
+	// Get the (fractional) index of the percentile.
+	rank := p.Mul(decimal.NewFromInt(int64(len(amounts) - 1))).Shift(-2)
+	lower := int(rank.IntPart())
+	frac := rank.Sub(decimal.NewFromInt(int64(lower)))
+
+	amount := amounts[lower]
+	if !frac.IsZero() {
+		amount = amount.Add(amounts[lower+1].Sub(amount).Mul(frac))
+	}
+
+	return Value{amount: amount, currency: values[0].currency}, nil
+}
+
+// Aggregate returns statistics about the given values.
+// The average is rounded to a multiple of the smallest unit of the currency by using the given rounding mode.
+// The currencies must not differ, and the list must not be empty.
+func Aggregate(values []Value, mode RoundingMode) (Statistics, error) {
+	if err := checkCurrencies(values); err != nil {
+		return Statistics{}, err
+	}
+
+	var smallestUnit Value // Default value is a smallest unit of 0. Which means that there is no smallest unit.
+	if cur := values[0].currency; cur != nil {
+		smallestUnit = cur.SmallestUnit()
+	}
+
+	return AggregateWithSmallestUnit(values, smallestUnit, mode)
+}
+
+// AggregateWithSmallestUnit returns statistics about the given values.
+// The average is rounded to a multiple of smallestUnit by using the given rounding mode.
+// The currencies must not differ, and the list must not be empty.
+func AggregateWithSmallestUnit(values []Value, smallestUnit Value, mode RoundingMode) (Statistics, error) {
+	if err := checkCurrencies(values); err != nil {
+		return Statistics{}, err
+	}
+
+	average, err := AverageWithSmallestUnit(values, smallestUnit, mode)
+	if err != nil {
+		return Statistics{}, fmt.Errorf("failed to calculate average: %w", err)
+	}
+
+	median, err := Median(values)
+	if err != nil {
+		return Statistics{}, fmt.Errorf("failed to calculate median: %w", err)
+	}
+
+	return Statistics{
+		Count:   len(values),
+		Sum:     MustSum(values[0], values[1:]...),
+		Min:     MustMin(values[0], values[1:]...),
+		Max:     MustMax(values[0], values[1:]...),
+		Average: average,
+		Median:  median,
+	}, nil
+}
+
+// GroupByCurrency returns the given values grouped by their currency.
+// The order of the values in each group is preserved.
+func GroupByCurrency(values []Value) map[Currency][]Value {
+	groups := map[Currency][]Value{}
+	for _, value := range values {
+		groups[value.currency] = append(groups[value.currency], value)
+	}
+
+	return groups
+}
+
+// AggregateByCurrency returns statistics about the given values for every currency.
+// Values without currency are put into the group with the nil key.
+// The averages are rounded to a multiple of the smallest unit of the respective currency by using the given rounding mode.
+//
+// As values without currency have no smallest unit, their average is rounded to a multiple of noCurrencySmallestUnit instead.
+// If noCurrencySmallestUnit is zero, values without currency result in an error, like with Aggregate.
+//
+//	AggregateByCurrency(values, Value{}, RoundHalfUp)                // Returns an error if any value has no currency.
+//	AggregateByCurrency(values, MustFromString("0.01"), RoundHalfUp) // Rounds the average of values without currency to 0.01.
+func AggregateByCurrency(values []Value, noCurrencySmallestUnit Value, mode RoundingMode) (map[Currency]Statistics, error) {
+	result := map[Currency]Statistics{}
+	for cur, group := range GroupByCurrency(values) {
+		var stats Statistics
+		var err error
+		if cur == nil {
+			stats, err = AggregateWithSmallestUnit(group, noCurrencySmallestUnit, mode)
+		} else {
+			stats, err = Aggregate(group, mode)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to aggregate values of currency %s: %w", helperCurrencyUniqueCode(cur), err)
+		}
+		result[cur] = stats
+	}
+
+	return result, nil
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []Value
+		want    int
+		wantErr bool
+	}{
+		{"comment_1", []Value{MustFromString("1 ISO4217-EUR"), MustFromString("2 ISO4217-EUR")}, 2, false},
+		{"comment_2", []Value{MustFromString("1 ISO4217-EUR"), MustFromString("2")}, 0, true},
+		{"1", nil, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Count(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Count() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Count() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAverageWithSmallestUnit(t *testing.T) {
+	type args struct {
+		values       []Value
+		smallestUnit Value
+		mode         RoundingMode
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", args{[]Value{MustFromString("1"), MustFromString("2")}, MustFromString("1"), RoundHalfUp}, MustFromString("2"), false},
+		{"comment_2", args{[]Value{MustFromString("1"), MustFromString("2")}, MustFromString("0.1"), RoundHalfUp}, MustFromString("1.5"), false},
+		{"1", args{[]Value{MustFromString("1"), MustFromString("1"), MustFromString("2")}, MustFromString("0.01"), RoundHalfUp}, MustFromString("1.33"), false},
+		{"2", args{[]Value{MustFromString("1"), MustFromString("2"), MustFromString("2")}, MustFromString("0.01"), RoundHalfUp}, MustFromString("1.67"), false},
+		{"3", args{[]Value{MustFromString("-1"), MustFromString("-2"), MustFromString("-2")}, MustFromString("0.01"), RoundDown}, MustFromString("-1.66"), false},
+		{"4", args{nil, MustFromString("0.01"), RoundHalfUp}, Value{}, true},
+		{"5", args{[]Value{MustFromString("1 ISO4217-EUR")}, MustFromString("0.01"), RoundHalfUp}, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AverageWithSmallestUnit(tt.args.values, tt.args.smallestUnit, tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AverageWithSmallestUnit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("AverageWithSmallestUnit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAverage(t *testing.T) {
+	type args struct {
+		values []Value
+		mode   RoundingMode
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", args{[]Value{MustFromString("1 ISO4217-EUR"), MustFromString("1.01 ISO4217-EUR")}, RoundHalfUp}, MustFromString("1.01 ISO4217-EUR"), false},
+		{"comment_2", args{[]Value{MustFromString("1 ISO4217-EUR"), MustFromString("1.01 ISO4217-EUR")}, RoundHalfEven}, MustFromString("1 ISO4217-EUR"), false},
+		{"comment_3", args{[]Value{MustFromString("1"), MustFromString("2")}, RoundHalfUp}, Value{}, true},
+		{"1", args{[]Value{MustFromString("1 ISO4217-EUR"), MustFromString("1 ISO4217-USD")}, RoundHalfUp}, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Average(tt.args.values, tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Average() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Average() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	type args struct {
+		values []Value
+		p      decimal.Decimal
+	}
+	values := []Value{MustFromString("4"), MustFromString("2"), MustFromString("1"), MustFromString("3")}
+	tests := []struct {
+		name    string
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", args{values, decimal.NewFromInt(0)}, MustFromString("1"), false},
+		{"comment_2", args{values, decimal.NewFromInt(25)}, MustFromString("1.75"), false},
+		{"comment_3", args{values, decimal.NewFromInt(100)}, MustFromString("4"), false},
+		{"1", args{values, decimal.NewFromInt(50)}, MustFromString("2.5"), false},
+		{"2", args{[]Value{MustFromString("5 ISO4217-EUR")}, decimal.NewFromInt(90)}, MustFromString("5 ISO4217-EUR"), false},
+		{"3", args{values, decimal.NewFromInt(101)}, Value{}, true},
+		{"4", args{values, decimal.NewFromInt(-1)}, Value{}, true},
+		{"5", args{nil, decimal.NewFromInt(50)}, Value{}, true},
+		{"6", args{[]Value{MustFromString("5 ISO4217-EUR"), MustFromString("5")}, decimal.NewFromInt(50)}, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Percentile(tt.args.values, tt.args.p)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Percentile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Percentile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []Value
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", []Value{MustFromString("3 ISO4217-EUR"), MustFromString("1 ISO4217-EUR"), MustFromString("2 ISO4217-EUR")}, MustFromString("2 ISO4217-EUR"), false},
+		{"comment_2", []Value{MustFromString("1.01 ISO4217-EUR"), MustFromString("1 ISO4217-EUR")}, MustFromString("1.005 ISO4217-EUR"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Median(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Median() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Median() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAggregateByCurrency(t *testing.T) {
+	eur, usd := ISO4217Currencies.ByCode("EUR"), ISO4217Currencies.ByCode("USD")
+
+	values := []Value{
+		MustFromString("1 ISO4217-EUR"),
+		MustFromString("10 ISO4217-USD"),
+		MustFromString("2 ISO4217-EUR"),
+		MustFromString("2 ISO4217-EUR"),
+	}
+
+	got, err := AggregateByCurrency(values, Value{}, RoundHalfUp)
+	if err != nil {
+		t.Fatalf("AggregateByCurrency() error = %v", err)
+	}
+
+	want := map[Currency]Statistics{
+		eur: {3, MustFromString("5 ISO4217-EUR"), MustFromString("1 ISO4217-EUR"), MustFromString("2 ISO4217-EUR"), MustFromString("1.67 ISO4217-EUR"), MustFromString("2 ISO4217-EUR")},
+		usd: {1, MustFromString("10 ISO4217-USD"), MustFromString("10 ISO4217-USD"), MustFromString("10 ISO4217-USD"), MustFromString("10 ISO4217-USD"), MustFromString("10 ISO4217-USD")},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("AggregateByCurrency() returned %d groups, want %d", len(got), len(want))
+	}
+	for cur, w := range want {
+		g := got[cur]
+		if g.Count != w.Count || !g.Sum.Equal(w.Sum) || !g.Min.Equal(w.Min) || !g.Max.Equal(w.Max) || !g.Average.Equal(w.Average) || !g.Median.Equal(w.Median) {
+			t.Errorf("AggregateByCurrency()[%s] = %v, want %v", helperCurrencyUniqueCode(cur), g, w)
+		}
+	}
+
+	// Values without currency have no smallest unit, like with Aggregate.
+	withoutCurrency := append(values, MustFromString("1"), MustFromString("1"), MustFromString("2"))
+	if _, err := AggregateByCurrency(withoutCurrency, Value{}, RoundHalfUp); err == nil {
+		t.Errorf("AggregateByCurrency() with currency-less values and no smallest unit did not fail")
+	}
+	if _, err := Aggregate(withoutCurrency[4:], RoundHalfUp); err == nil {
+		t.Errorf("Aggregate() with currency-less values did not fail")
+	}
+
+	// With an explicit smallest unit, values without currency form their own group.
+	for _, tt := range []struct {
+		mode        RoundingMode
+		wantAverage string
+	}{
+		{RoundHalfUp, "1.33"},
+		{RoundUp, "1.34"},
+	} {
+		got, err = AggregateByCurrency(withoutCurrency, MustFromString("0.01"), tt.mode)
+		if err != nil {
+			t.Fatalf("AggregateByCurrency() with currency-less values error = %v", err)
+		}
+		if len(got) != 3 {
+			t.Fatalf("AggregateByCurrency() with currency-less values returned %d groups, want 3", len(got))
+		}
+		if g, w := got[eur], want[eur]; g.Count != w.Count || !g.Sum.Equal(w.Sum) || !g.Average.Equal(w.Average) {
+			t.Errorf("AggregateByCurrency()[%s] = %v, want %v", helperCurrencyUniqueCode(eur), g, w)
+		}
+		w := Statistics{3, MustFromString("4"), MustFromString("1"), MustFromString("2"), MustFromString(tt.wantAverage), MustFromString("1")}
+		if g := got[nil]; g.Count != w.Count || !g.Sum.Equal(w.Sum) || !g.Min.Equal(w.Min) || !g.Max.Equal(w.Max) || !g.Average.Equal(w.Average) || !g.Median.Equal(w.Median) {
+			t.Errorf("AggregateByCurrency()[%s] = %v, want %v", helperCurrencyUniqueCode(nil), g, w)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package tax calculates net, gross and tax amounts of monetary values.
+//
+// All results are rounded to the smallest unit of the currency, and it is guaranteed that the net amount plus all taxes sum exactly to the gross amount.
+package tax
+
+import (
+	"fmt"
+	"sort"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/shopspring/decimal"
+)
+
+// Mode defines whether prices already contain the taxes or not.
+type Mode int
+
+const (
+	Exclusive Mode = iota // Prices are net amounts, the taxes are added on top. This is the default.
+	Inclusive             // Prices are gross amounts, the taxes are already included.
+)
+
+// RoundingStrategy defines at which level taxes are rounded.
+type RoundingStrategy int
+
+const (
+	RoundPerLine    RoundingStrategy = iota // Taxes are rounded for every line, the totals are the sums of the rounded lines. This is the default.
+	RoundPerInvoice                         // Taxes are calculated from the sum of all lines and rounded once, the rounded taxes are then distributed to the lines.
+)
+
+// Rate defines a single tax rate.
+type Rate struct {
+	Name     string     // Name of the tax, e.g. "VAT". This is not used in calculations.
+	Rate     money.Rate // Rate of the tax, e.g. 19%.
+	Compound bool       // If true, the tax is calculated on the net amount plus all preceding taxes. Otherwise the tax is only calculated on the net amount.
+}
+
+// Breakdown contains the result of a tax calculation.
+// Net plus all Taxes is always exactly Gross.
+type Breakdown struct {
+	Net   money.Value   // Net is the amount without taxes.
+	Taxes []money.Value // Taxes contains the amount of every tax rate, in the same order as the rates of the calculator.
+	Gross money.Value   // Gross is the amount including all taxes.
+}
+
+// Tax returns the sum of all taxes.
+func (b Breakdown) Tax() money.Value {
+	return b.Gross.MustSub(b.Net)
+}
+
+// Calculator calculates taxes for prices.
+// The zero value is a calculator for tax exclusive prices without any tax rates.
+type Calculator struct {
+	Mode     Mode               // Mode defines whether prices are net or gross amounts.
+	Rates    []Rate             // Rates contains all tax rates that are applied to a price, in order.
+	Rounding money.RoundingMode // Rounding is the rounding mode that is used to round the taxes to the smallest unit of the currency.
+	Strategy RoundingStrategy   // Strategy defines at which level taxes are rounded when calculating multiple lines.
+}
+
+// factors returns the factor of every tax rate relative to the net amount, and the sum of all factors.
+// For compound rates, this includes the taxes of the preceding rates.
+func (c Calculator) factors() ([]decimal.Decimal, decimal.Decimal, error) {
+	factors, total := make([]decimal.Decimal, len(c.Rates)), decimal.Zero
+	for i, rate := range c.Rates {
+		ratio := rate.Rate.Ratio()
+		if ratio.IsNegative() {
+			return nil, decimal.Zero, fmt.Errorf("tax rate %q with %v is negative", rate.Name, rate.Rate)
+		}
+
+		if rate.Compound {
+			factors[i] = ratio.Mul(decimal.NewFromInt(1).Add(total))
+		} else {
+			factors[i] = ratio
+		}
+		total = total.Add(factors[i])
+	}
+
+	return factors, total, nil
+}
+
+// Distribute splits the given value proportionally to the given weights.
+// The parts are multiples of the smallest unit of the currency of the value, and sum exactly to the value.
+// If the value can't be split exactly, the remaining smallest units are distributed one by one to the parts with the largest remainders.
+//
+// Unlike money.Value.Allocate, weights may be negative, as long as their sum isn't zero.
+// This allows to distribute rounded invoice totals to credit or refund lines, which receive parts with the opposite sign.
+// A value of zero can always be distributed, even if all weights are zero.
+//
+//	tax.Distribute(money.MustFromString("1 ISO4217-EUR"), []decimal.Decimal{decimal.NewFromInt(3), decimal.NewFromInt(-2)}) // Returns the two EUR values `3`, `-2`.
+func Distribute(v money.Value, weights []decimal.Decimal) ([]money.Value, error) {
+	parts := make([]money.Value, len(weights))
+	if v.IsZero() {
+		for i := range parts {
+			parts[i] = v
+		}
+		return parts, nil
+	}
+
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("list of weights is empty")
+	}
+
+	totalWeight := decimal.Zero
+	for _, weight := range weights {
+		totalWeight = totalWeight.Add(weight)
+	}
+	if totalWeight.IsZero() {
+		return nil, fmt.Errorf("sum of weights must not be zero")
+	}
+
+	var smallestUnit decimal.Decimal
+	if cur := v.Currency(); cur != nil {
+		smallestUnit = cur.SmallestUnit().Decimal()
+	}
+	if !smallestUnit.IsPositive() {
+		return nil, fmt.Errorf("value %v has no smallest unit", v)
+	}
+
+	// Get the amount of smallest units that have to be distributed.
+	// Like with money.Value.Allocate, negative values are distributed as positive amounts of negative smallest units.
+	q, r := v.Decimal().QuoRem(smallestUnit, 0)
+	if !r.IsZero() {
+		return nil, fmt.Errorf("value %v is not a multiple of the smallest unit %s", v, smallestUnit)
+	}
+	if q.IsNegative() {
+		q, smallestUnit = q.Neg(), smallestUnit.Neg()
+	}
+	if totalWeight.IsNegative() {
+		totalWeight = totalWeight.Neg()
+		weights = append([]decimal.Decimal(nil), weights...)
+		for i, weight := range weights {
+			weights[i] = weight.Neg()
+		}
+	}
+
+	// Get the amount of smallest units per part, rounded towards negative infinity.
+	// All remainders are in the range [0, totalWeight), so they can be compared directly.
+	units, remainders := make([]decimal.Decimal, len(weights)), make([]decimal.Decimal, len(weights))
+	distributed := decimal.Zero
+	for i, weight := range weights {
+		units[i], remainders[i] = q.Mul(weight).QuoRem(totalWeight, 0)
+		if remainders[i].IsNegative() {
+			units[i], remainders[i] = units[i].Sub(decimal.NewFromInt(1)), remainders[i].Add(totalWeight)
+		}
+		distributed = distributed.Add(units[i])
+	}
+
+	// Distribute the remaining smallest units to the parts with the largest remainders.
+	// There are less remaining smallest units than parts, so this fits into an int.
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]].GreaterThan(remainders[order[j]]) })
+	for _, i := range order[:int(q.Sub(distributed).IntPart())] {
+		units[i] = units[i].Add(decimal.NewFromInt(1))
+	}
+
+	for i := range parts {
+		parts[i] = money.FromDecimal(units[i].Mul(smallestUnit), v.Currency())
+	}
+
+	return parts, nil
+}
+
+// Calculate returns the tax breakdown of a single price.
+// Depending on the mode of the calculator, the price is either a net or a gross amount.
+//
+// The total tax is rounded once to the smallest unit of the currency, and then distributed to the single tax rates.
+// Therefore the price must have a currency with a smallest unit.
+func (c Calculator) Calculate(price money.Value) (Breakdown, error) {
+	factors, total, err := c.factors()
+	if err != nil {
+		return Breakdown{}, err
+	}
+
+	var tax money.Value
+	switch c.Mode {
+	case Exclusive:
+		// tax = net * total.
+		if tax, err = price.ApplyPercent(money.NewRate(total), c.Rounding); err != nil {
+			return Breakdown{}, fmt.Errorf("failed to calculate tax: %w", err)
+		}
+	case Inclusive:
+		// tax = gross * total / (1 + total).
+		if tax, err = price.MustMul(money.NewRate(total).Value()).DivRound(money.FromDecimal(decimal.NewFromInt(1).Add(total), nil), c.Rounding); err != nil {
+			return Breakdown{}, fmt.Errorf("failed to calculate tax: %w", err)
+		}
+	default:
+		return Breakdown{}, fmt.Errorf("unknown tax mode %d", c.Mode)
+	}
+
+	taxes, err := Distribute(tax, factors)
+	if err != nil {
+		return Breakdown{}, fmt.Errorf("failed to distribute tax %v: %w", tax, err)
+	}
+
+	return c.breakdown(price, taxes), nil
+}
+
+// breakdown returns the breakdown of the given price and its taxes.
+func (c Calculator) breakdown(price money.Value, taxes []money.Value) Breakdown {
+	b := Breakdown{Net: price, Taxes: taxes, Gross: price}
+	for _, tax := range taxes {
+		if c.Mode == Inclusive {
+			b.Net = b.Net.MustSub(tax)
+		} else {
+			b.Gross = b.Gross.MustAdd(tax)
+		}
+	}
+
+	return b
+}
+
+// CalculateLines returns the tax breakdown of every given price, and the breakdown of the total.
+// All prices must have the same currency.
+//
+// The breakdowns of all lines always sum exactly to the total breakdown.
+// How the taxes are rounded depends on the rounding strategy of the calculator:
+//
+//   - RoundPerLine: Every line is calculated with Calculate, the total is the sum of all lines.
+//   - RoundPerInvoice: The total is calculated with Calculate from the sum of all prices.
+//     The taxes of the total are then distributed proportionally to the prices of the lines with Distribute, so negative prices receive negative taxes.
+func (c Calculator) CalculateLines(prices []money.Value) ([]Breakdown, Breakdown, error) {
+	if len(prices) == 0 {
+		return nil, Breakdown{}, fmt.Errorf("list of prices is empty")
+	}
+
+	sum, err := money.Sum(prices[0], prices[1:]...)
+	if err != nil {
+		return nil, Breakdown{}, err
+	}
+
+	lines := make([]Breakdown, len(prices))
+
+	switch c.Strategy {
+	case RoundPerLine:
+		for i, price := range prices {
+			if lines[i], err = c.Calculate(price); err != nil {
+				return nil, Breakdown{}, fmt.Errorf("failed to calculate line %d: %w", i, err)
+			}
+		}
+
+		total := Breakdown{Net: lines[0].Net, Taxes: append([]money.Value(nil), lines[0].Taxes...), Gross: lines[0].Gross}
+		for _, line := range lines[1:] {
+			total.Net, total.Gross = total.Net.MustAdd(line.Net), total.Gross.MustAdd(line.Gross)
+			for j, tax := range line.Taxes {
+				total.Taxes[j] = total.Taxes[j].MustAdd(tax)
+			}
+		}
+
+		return lines, total, nil
+
+	case RoundPerInvoice:
+		total, err := c.Calculate(sum)
+		if err != nil {
+			return nil, Breakdown{}, err
+		}
+
+		weights := make([]decimal.Decimal, len(prices))
+		for i, price := range prices {
+			weights[i] = price.Decimal()
+		}
+
+		// Distribute every tax proportionally to the prices.
+		lineTaxes := make([][]money.Value, len(prices))
+		for i := range lineTaxes {
+			lineTaxes[i] = make([]money.Value, len(total.Taxes))
+		}
+		for j, tax := range total.Taxes {
+			parts, err := Distribute(tax, weights)
+			if err != nil {
+				return nil, Breakdown{}, fmt.Errorf("failed to distribute tax %v to the lines: %w", tax, err)
+			}
+			for i, part := range parts {
+				lineTaxes[i][j] = part
+			}
+		}
+
+		for i, price := range prices {
+			lines[i] = c.breakdown(price, lineTaxes[i])
+		}
+
+		return lines, total, nil
+	}
+
+	return nil, Breakdown{}, fmt.Errorf("unknown rounding strategy %d", c.Strategy)
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tax
+
+import (
+	"testing"
+
+	money "github.com/Dadido3/D3money"
+	"github.com/shopspring/decimal"
+)
+
+// checkBreakdown checks if the breakdown matches the expected values, and if its parts sum to the gross amount.
+func checkBreakdown(t *testing.T, got Breakdown, net string, taxes []string, gross string) {
+	t.Helper()
+
+	if !got.Net.Equal(money.MustFromString(net)) {
+		t.Errorf("Net = %v, want %v", got.Net, net)
+	}
+	if len(got.Taxes) != len(taxes) {
+		t.Fatalf("Got %d taxes, want %d", len(got.Taxes), len(taxes))
+	}
+	for i, tax := range taxes {
+		if !got.Taxes[i].Equal(money.MustFromString(tax)) {
+			t.Errorf("Taxes[%d] = %v, want %v", i, got.Taxes[i], tax)
+		}
+	}
+	if !got.Gross.Equal(money.MustFromString(gross)) {
+		t.Errorf("Gross = %v, want %v", got.Gross, gross)
+	}
+
+	if sum := money.MustSum(got.Net, got.Taxes...); !sum.Equal(got.Gross) {
+		t.Errorf("Net %v plus taxes %v sum to %v, want %v", got.Net, got.Taxes, sum, got.Gross)
+	}
+}
+
+func TestCalculator_Calculate(t *testing.T) {
+	vat := Rate{Name: "VAT", Rate: money.MustParseRate("19%")}
+	gst := Rate{Name: "GST", Rate: money.MustParseRate("5%")}
+	qst := Rate{Name: "QST", Rate: money.MustParseRate("8.5%"), Compound: true}
+	pst := Rate{Name: "PST", Rate: money.MustParseRate("7%")}
+
+	tests := []struct {
+		name      string
+		c         Calculator
+		price     string
+		wantNet   string
+		wantTaxes []string
+		wantGross string
+		wantErr   bool
+	}{
+		{"1", Calculator{Rates: []Rate{vat}}, "100 ISO4217-EUR", "100 ISO4217-EUR", []string{"19 ISO4217-EUR"}, "119 ISO4217-EUR", false},
+		{"2", Calculator{Rates: []Rate{vat}}, "0.99 ISO4217-EUR", "0.99 ISO4217-EUR", []string{"0.19 ISO4217-EUR"}, "1.18 ISO4217-EUR", false},
+		{"3", Calculator{Rates: []Rate{vat}, Rounding: money.RoundDown}, "0.99 ISO4217-EUR", "0.99 ISO4217-EUR", []string{"0.18 ISO4217-EUR"}, "1.17 ISO4217-EUR", false},
+		{"4", Calculator{Mode: Inclusive, Rates: []Rate{vat}}, "119 ISO4217-EUR", "100 ISO4217-EUR", []string{"19 ISO4217-EUR"}, "119 ISO4217-EUR", false},
+		{"5", Calculator{Mode: Inclusive, Rates: []Rate{vat}}, "9.99 ISO4217-EUR", "8.39 ISO4217-EUR", []string{"1.6 ISO4217-EUR"}, "9.99 ISO4217-EUR", false},
+		{"6", Calculator{Mode: Inclusive, Rates: []Rate{vat}}, "-9.99 ISO4217-EUR", "-8.39 ISO4217-EUR", []string{"-1.6 ISO4217-EUR"}, "-9.99 ISO4217-EUR", false},
+		{"7", Calculator{Rates: []Rate{gst, qst}}, "100 ISO4217-CAD", "100 ISO4217-CAD", []string{"5 ISO4217-CAD", "8.93 ISO4217-CAD"}, "113.93 ISO4217-CAD", false},
+		{"8", Calculator{Rates: []Rate{gst, pst}}, "100 ISO4217-CAD", "100 ISO4217-CAD", []string{"5 ISO4217-CAD", "7 ISO4217-CAD"}, "112 ISO4217-CAD", false},
+		{"9", Calculator{Mode: Inclusive, Rates: []Rate{gst, qst}}, "113.93 ISO4217-CAD", "100 ISO4217-CAD", []string{"5 ISO4217-CAD", "8.93 ISO4217-CAD"}, "113.93 ISO4217-CAD", false},
+		{"10", Calculator{Mode: Inclusive, Rates: []Rate{gst, pst}}, "10 ISO4217-CAD", "8.93 ISO4217-CAD", []string{"0.45 ISO4217-CAD", "0.62 ISO4217-CAD"}, "10 ISO4217-CAD", false},
+		{"11", Calculator{}, "10 ISO4217-EUR", "10 ISO4217-EUR", []string{}, "10 ISO4217-EUR", false},
+		{"12", Calculator{Rates: []Rate{{Rate: money.MustParseRate("0%")}}}, "10 ISO4217-EUR", "10 ISO4217-EUR", []string{"0 ISO4217-EUR"}, "10 ISO4217-EUR", false},
+		{"13", Calculator{Rates: []Rate{vat}}, "100", "", nil, "", true},
+		{"14", Calculator{Rates: []Rate{{Rate: money.MustParseRate("-1%")}}}, "100 ISO4217-EUR", "", nil, "", true},
+		{"15", Calculator{Mode: Mode(-1), Rates: []Rate{vat}}, "100 ISO4217-EUR", "", nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Calculate(money.MustFromString(tt.price))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Calculator.Calculate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil {
+				checkBreakdown(t, got, tt.wantNet, tt.wantTaxes, tt.wantGross)
+			}
+		})
+	}
+}
+
+func TestCalculator_CalculateLines(t *testing.T) {
+	vat := Rate{Name: "VAT", Rate: money.MustParseRate("19%")}
+	prices := []money.Value{
+		money.MustFromString("0.99 ISO4217-EUR"),
+		money.MustFromString("0.99 ISO4217-EUR"),
+		money.MustFromString("0.99 ISO4217-EUR"),
+	}
+
+	// Round every line.
+	c := Calculator{Rates: []Rate{vat}, Strategy: RoundPerLine}
+	lines, total, err := c.CalculateLines(prices)
+	if err != nil {
+		t.Fatalf("Calculator.CalculateLines() failed: %v", err)
+	}
+	for _, line := range lines {
+		checkBreakdown(t, line, "0.99 ISO4217-EUR", []string{"0.19 ISO4217-EUR"}, "1.18 ISO4217-EUR")
+	}
+	checkBreakdown(t, total, "2.97 ISO4217-EUR", []string{"0.57 ISO4217-EUR"}, "3.54 ISO4217-EUR")
+
+	// Round the total, and distribute the taxes to the lines.
+	c.Strategy = RoundPerInvoice
+	lines, total, err = c.CalculateLines(prices)
+	if err != nil {
+		t.Fatalf("Calculator.CalculateLines() failed: %v", err)
+	}
+	checkBreakdown(t, lines[0], "0.99 ISO4217-EUR", []string{"0.19 ISO4217-EUR"}, "1.18 ISO4217-EUR")
+	checkBreakdown(t, lines[1], "0.99 ISO4217-EUR", []string{"0.19 ISO4217-EUR"}, "1.18 ISO4217-EUR")
+	checkBreakdown(t, lines[2], "0.99 ISO4217-EUR", []string{"0.18 ISO4217-EUR"}, "1.17 ISO4217-EUR")
+	checkBreakdown(t, total, "2.97 ISO4217-EUR", []string{"0.56 ISO4217-EUR"}, "3.53 ISO4217-EUR")
+
+	// Same for tax inclusive prices.
+	c.Mode = Inclusive
+	lines, total, err = c.CalculateLines(prices)
+	if err != nil {
+		t.Fatalf("Calculator.CalculateLines() failed: %v", err)
+	}
+	checkBreakdown(t, lines[0], "0.83 ISO4217-EUR", []string{"0.16 ISO4217-EUR"}, "0.99 ISO4217-EUR")
+	checkBreakdown(t, lines[1], "0.83 ISO4217-EUR", []string{"0.16 ISO4217-EUR"}, "0.99 ISO4217-EUR")
+	checkBreakdown(t, lines[2], "0.84 ISO4217-EUR", []string{"0.15 ISO4217-EUR"}, "0.99 ISO4217-EUR")
+	checkBreakdown(t, total, "2.5 ISO4217-EUR", []string{"0.47 ISO4217-EUR"}, "2.97 ISO4217-EUR")
+
+	// Negative prices of credit lines receive negative taxes.
+	c.Mode = Exclusive
+	lines, total, err = c.CalculateLines([]money.Value{money.MustFromString("10.99 ISO4217-EUR"), money.MustFromString("-0.99 ISO4217-EUR"), money.MustFromString("0.99 ISO4217-EUR")})
+	if err != nil {
+		t.Fatalf("Calculator.CalculateLines() with negative prices failed: %v", err)
+	}
+	checkBreakdown(t, lines[0], "10.99 ISO4217-EUR", []string{"2.09 ISO4217-EUR"}, "13.08 ISO4217-EUR")
+	checkBreakdown(t, lines[1], "-0.99 ISO4217-EUR", []string{"-0.19 ISO4217-EUR"}, "-1.18 ISO4217-EUR")
+	checkBreakdown(t, lines[2], "0.99 ISO4217-EUR", []string{"0.19 ISO4217-EUR"}, "1.18 ISO4217-EUR")
+	checkBreakdown(t, total, "10.99 ISO4217-EUR", []string{"2.09 ISO4217-EUR"}, "13.08 ISO4217-EUR")
+
+	// Mixed currencies.
+	if _, _, err := c.CalculateLines([]money.Value{money.MustFromString("1 ISO4217-EUR"), money.MustFromString("1 ISO4217-USD")}); err == nil {
+		t.Errorf("Calculator.CalculateLines() with mixed currencies did not fail")
+	}
+
+	// No lines.
+	if _, _, err := c.CalculateLines(nil); err == nil {
+		t.Errorf("Calculator.CalculateLines() without lines did not fail")
+	}
+}
+
+func TestDistribute(t *testing.T) {
+	weights := func(ints ...int64) []decimal.Decimal {
+		res := make([]decimal.Decimal, len(ints))
+		for i, n := range ints {
+			res[i] = decimal.NewFromInt(n)
+		}
+		return res
+	}
+
+	tests := []struct {
+		name    string
+		v       money.Value
+		weights []decimal.Decimal
+		want    []string
+		wantErr bool
+	}{
+		{"comment_1", money.MustFromString("1 ISO4217-EUR"), weights(3, -2), []string{"3", "-2"}, false},
+		{"1", money.MustFromString("100 ISO4217-EUR"), weights(1, 2), []string{"33.33", "66.67"}, false},
+		{"2", money.MustFromString("-0.05 ISO4217-EUR"), weights(1, 1), []string{"-0.03", "-0.02"}, false},
+		{"3", money.MustFromString("10 ISO4217-EUR"), weights(7, 7, -3, 0), []string{"6.37", "6.36", "-2.73", "0"}, false},
+		{"4", money.MustFromString("-10 ISO4217-EUR"), weights(-7, -7, 3), []string{"-6.37", "-6.36", "2.73"}, false},
+		{"5", money.MustFromString("10 ISO4217-EUR"), weights(-1, -2), []string{"3.33", "6.67"}, false},
+		{"6", money.MustFromString("0 ISO4217-EUR"), weights(1, -1), []string{"0", "0"}, false},
+		{"7", money.MustFromString("1 ISO4217-EUR"), weights(1, -1), nil, true},
+		{"8", money.MustFromString("1 ISO4217-EUR"), nil, nil, true},
+		{"9", money.MustFromString("1.001 ISO4217-EUR"), weights(1), nil, true},
+		{"10", money.MustFromString("1"), weights(1), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Distribute(tt.v, tt.weights)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Distribute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Distribute() returned %d parts, want %d", len(got), len(tt.want))
+			}
+			sum := money.FromInt64(0, tt.v.Currency())
+			for i, part := range got {
+				if want := money.MustFromStringAndCurrency(tt.want[i], tt.v.Currency()); !part.Equal(want) {
+					t.Errorf("Distribute() = %v, want %v", got, tt.want)
+					break
+				}
+				sum = sum.MustAdd(part)
+			}
+			if !sum.Equal(tt.v) {
+				t.Errorf("Parts sum to %v, want %v", sum, tt.v)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// Version bytes of the binary format.
+//
+// Legacy data starts with the most significant byte of the big-endian unique ID.
+// To tell both apart, unique IDs whose most significant byte equals a version byte are rejected by ValidateCurrency.
+// These IDs are positive, and therefore part of the range that is reserved for currencies of this library anyway.
+const (
+	binaryVersion1 = 0x71 // binaryVersion1 is the version byte of the compact binary format. This reserves the unique IDs 0x71000000 to 0x71FFFFFF.
+)
+
+// isBinaryReservedUniqueID returns whether the most significant byte of the given unique ID collides with a version byte of the binary format.
+func isBinaryReservedUniqueID(uniqueID int32) bool {
+	return uint32(uniqueID)>>24 == binaryVersion1
+}
+
+// appendBinaryV1 appends the compact binary representation of the given amount and currency to buf.
+// See Value.UnmarshalBinary for a description of the format.
+func appendBinaryV1(buf []byte, amount decimal.Decimal, uniqueID int32) []byte {
+	buf = append(buf, binaryVersion1)
+	buf = binary.AppendVarint(buf, int64(uniqueID))
+	buf = binary.AppendVarint(buf, int64(amount.Exponent()))
+
+	mantissa := amount.Coefficient()
+	if mantissa.IsInt64() {
+		return binary.AppendVarint(buf, mantissa.Int64())
+	}
+
+	// A varint never starts with a 0x00 byte that is followed by more data, which marks big mantissas.
+	return appendTwosComplement(append(buf, 0x00), mantissa)
+}
+
+// parseBinaryV1 returns the amount and the unique ID of the currency of the given compact binary representation.
+func parseBinaryV1(data []byte) (decimal.Decimal, int32, error) {
+	r := data[1:]
+
+	uniqueID, n := binary.Varint(r)
+	if n <= 0 {
+		return decimal.Decimal{}, 0, fmt.Errorf("error decoding binary %x: invalid currency ID", data)
+	}
+	if uniqueID < math.MinInt32 || uniqueID > math.MaxInt32 {
+		return decimal.Decimal{}, 0, fmt.Errorf("error decoding binary %x: currency ID %d overflows int32", data, uniqueID)
+	}
+	r = r[n:]
+
+	exponent, n := binary.Varint(r)
+	if n <= 0 {
+		return decimal.Decimal{}, 0, fmt.Errorf("error decoding binary %x: invalid exponent", data)
+	}
+	if exponent < math.MinInt32 || exponent > math.MaxInt32 {
+		return decimal.Decimal{}, 0, fmt.Errorf("error decoding binary %x: exponent %d overflows int32", data, exponent)
+	}
+	r = r[n:]
+
+	if len(r) == 0 {
+		return decimal.Decimal{}, 0, fmt.Errorf("error decoding binary %x: missing mantissa", data)
+	}
+
+	if r[0] == 0x00 && len(r) > 1 {
+		mantissa := parseTwosComplement(r[1:])
+		if mantissa.IsInt64() {
+			return decimal.Decimal{}, 0, fmt.Errorf("error decoding binary %x: big mantissa %s fits into a varint", data, mantissa)
+		}
+		return decimal.NewFromBigInt(mantissa, int32(exponent)), int32(uniqueID), nil
+	}
+
+	mantissa, n := binary.Varint(r)
+	if n <= 0 || n != len(r) {
+		return decimal.Decimal{}, 0, fmt.Errorf("error decoding binary %x: invalid mantissa", data)
+	}
+
+	return decimal.New(mantissa, int32(exponent)), int32(uniqueID), nil
+}
+
+// parseBinaryLegacy returns the amount and the unique ID of the currency of the given legacy binary representation.
+func parseBinaryLegacy(data []byte) (decimal.Decimal, int32, error) {
+	if len(data) < 4 {
+		return decimal.Decimal{}, 0, fmt.Errorf("error decoding binary %v: expected at least 4 bytes, got %d", data, len(data))
+	}
+
+	var amount decimal.Decimal
+	if err := amount.UnmarshalBinary(data[4:]); err != nil {
+		return decimal.Decimal{}, 0, err
+	}
+
+	return amount, int32(binary.BigEndian.Uint32(data[:4])), nil
+}
@@ -0,0 +1,321 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// CBOR major types, see RFC 8949 section 3.1.
+const (
+	cborUnsigned   = 0
+	cborNegative   = 1
+	cborByteString = 2
+	cborArray      = 4
+	cborTag        = 6
+)
+
+// CBOR tags, see RFC 8949 section 3.4.
+const (
+	cborTagPositiveBignum  = 2
+	cborTagNegativeBignum  = 3
+	cborTagDecimalFraction = 4
+)
+
+// MarshalCBOR returns the value as CBOR data item.
+//
+// The value is encoded as array of two elements.
+// The first element is the amount as decimal fraction (tag 4) as defined in RFC 8949 section 3.4.4, the second element is the unique ID of the currency, or 0 if there is no currency.
+// Mantissas that don't fit into a CBOR integer are encoded as bignums.
+//
+//	MustFromString("-12.34 ISO4217-EUR").MarshalCBOR() // Returns [4([-2, -1234]), 42170978], which is 0x82c482213904d11a02837a62.
+func (v Value) MarshalCBOR() ([]byte, error) {
+	var uniqueID int32
+	if v.currency != nil {
+		uniqueID = v.currency.UniqueID()
+	}
+
+	buf := appendCBORHead(nil, cborArray, 2)
+	buf = appendCBORHead(buf, cborTag, cborTagDecimalFraction)
+	buf = appendCBORHead(buf, cborArray, 2)
+	buf = appendCBORInt(buf, int64(v.amount.Exponent()))
+	buf = appendCBORBigInt(buf, v.amount.Coefficient())
+	buf = appendCBORInt(buf, int64(uniqueID))
+
+	return buf, nil
+}
+
+// UnmarshalCBOR fills the object with data from the given CBOR data item, as written by MarshalCBOR.
+//
+// A plain decimal fraction (tag 4) without the surrounding array is decoded as value without currency.
+// Indefinite length items are not supported.
+//
+// Currencies are looked up in the global Currencies collection, use a Decoder to decode against a different collection.
+func (v *Value) UnmarshalCBOR(data []byte) error {
+	amount, cur, err := unmarshalCBOR(data, Currencies)
+	if err != nil {
+		return err
+	}
+
+	v.amount, v.currency = amount, cur
+
+	return nil
+}
+
+// unmarshalCBOR parses the CBOR representation of a value.
+// The unique ID of the currency is looked up in the given collection.
+func unmarshalCBOR(data []byte, cc *CurrencyCollection) (decimal.Decimal, Currency, error) {
+	amount, uniqueID, err := parseCBORValue(&cborReader{data: data})
+	if err != nil {
+		return decimal.Decimal{}, nil, fmt.Errorf("error decoding CBOR %x: %w", data, err)
+	}
+
+	var cur Currency
+	if uniqueID != 0 {
+		if cur = cc.ByUniqueID(uniqueID); cur == nil {
+			return decimal.Decimal{}, nil, &ErrorCantFindUniqueID{uniqueID}
+		}
+	}
+
+	return amount, cur, nil
+}
+
+// parseCBORValue reads a value from r and returns its amount and the unique ID of its currency.
+// r must contain exactly one data item.
+func parseCBORValue(r *cborReader) (decimal.Decimal, int32, error) {
+	major, arg, err := r.head()
+	if err != nil {
+		return decimal.Decimal{}, 0, err
+	}
+
+	var amount decimal.Decimal
+	var uniqueID int32
+
+	switch {
+	case major == cborTag && arg == cborTagDecimalFraction:
+		// Plain decimal fraction without currency.
+		if amount, err = parseCBORDecimalFraction(r); err != nil {
+			return decimal.Decimal{}, 0, err
+		}
+
+	case major == cborArray && arg == 2:
+		if major, arg, err = r.head(); err != nil {
+			return decimal.Decimal{}, 0, err
+		}
+		if major != cborTag || arg != cborTagDecimalFraction {
+			return decimal.Decimal{}, 0, fmt.Errorf("amount is not a decimal fraction")
+		}
+		if amount, err = parseCBORDecimalFraction(r); err != nil {
+			return decimal.Decimal{}, 0, err
+		}
+
+		id, err := r.int()
+		if err != nil {
+			return decimal.Decimal{}, 0, fmt.Errorf("failed to read currency ID: %w", err)
+		}
+		if !id.IsInt64() || id.Int64() < math.MinInt32 || id.Int64() > math.MaxInt32 {
+			return decimal.Decimal{}, 0, fmt.Errorf("currency ID %s overflows int32", id)
+		}
+		uniqueID = int32(id.Int64())
+
+	default:
+		return decimal.Decimal{}, 0, fmt.Errorf("expected array of 2 elements or decimal fraction, got major type %d with argument %d", major, arg)
+	}
+
+	if remaining := len(r.data) - r.pos; remaining > 0 {
+		return decimal.Decimal{}, 0, fmt.Errorf("%d trailing bytes", remaining)
+	}
+
+	return amount, uniqueID, nil
+}
+
+// parseCBORDecimalFraction reads the content of a decimal fraction from r.
+// The tag itself must already be consumed.
+func parseCBORDecimalFraction(r *cborReader) (decimal.Decimal, error) {
+	major, arg, err := r.head()
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if major != cborArray || arg != 2 {
+		return decimal.Decimal{}, fmt.Errorf("decimal fraction is not an array of 2 elements")
+	}
+
+	// RFC 8949 doesn't allow bignums as exponent.
+	major, arg, err = r.head()
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	var exponent int64
+	switch major {
+	case cborUnsigned:
+		if arg > math.MaxInt32 {
+			return decimal.Decimal{}, fmt.Errorf("exponent %d overflows int32", arg)
+		}
+		exponent = int64(arg)
+	case cborNegative:
+		if arg > -(math.MinInt32 + 1) {
+			return decimal.Decimal{}, fmt.Errorf("exponent -1-%d overflows int32", arg)
+		}
+		exponent = -1 - int64(arg)
+	default:
+		return decimal.Decimal{}, fmt.Errorf("exponent has major type %d, expected an integer", major)
+	}
+
+	mantissa, err := r.int()
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("failed to read mantissa: %w", err)
+	}
+
+	return decimal.NewFromBigInt(mantissa, int32(exponent)), nil
+}
+
+// appendCBORHead appends the initial byte and the argument of a data item to buf.
+// The shortest possible encoding of the argument is used.
+func appendCBORHead(buf []byte, major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return append(buf, major<<5|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(buf, major<<5|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(buf, major<<5|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(buf, major<<5|26), uint32(arg))
+	}
+
+	return binary.BigEndian.AppendUint64(append(buf, major<<5|27), arg)
+}
+
+// appendCBORInt appends x as CBOR integer to buf.
+func appendCBORInt(buf []byte, x int64) []byte {
+	if x < 0 {
+		return appendCBORHead(buf, cborNegative, uint64(-1-x))
+	}
+
+	return appendCBORHead(buf, cborUnsigned, uint64(x))
+}
+
+// appendCBORBigInt appends x as CBOR integer to buf.
+// If x doesn't fit into a CBOR integer, it is encoded as bignum.
+func appendCBORBigInt(buf []byte, x *big.Int) []byte {
+	if x.Sign() >= 0 {
+		if x.IsUint64() {
+			return appendCBORHead(buf, cborUnsigned, x.Uint64())
+		}
+		b := x.Bytes()
+		buf = appendCBORHead(buf, cborTag, cborTagPositiveBignum)
+		buf = appendCBORHead(buf, cborByteString, uint64(len(b)))
+		return append(buf, b...)
+	}
+
+	// Negative integers are encoded as -1-n.
+	n := new(big.Int).Not(x)
+	if n.IsUint64() {
+		return appendCBORHead(buf, cborNegative, n.Uint64())
+	}
+	b := n.Bytes()
+	buf = appendCBORHead(buf, cborTag, cborTagNegativeBignum)
+	buf = appendCBORHead(buf, cborByteString, uint64(len(b)))
+	return append(buf, b...)
+}
+
+// cborReader reads data items from a CBOR encoded byte slice.
+type cborReader struct {
+	data []byte
+	pos  int
+}
+
+// head reads the initial byte and the argument of the next data item.
+// Indefinite lengths and reserved values are rejected.
+func (r *cborReader) head() (major byte, arg uint64, err error) {
+	if r.pos >= len(r.data) {
+		return 0, 0, fmt.Errorf("unexpected end of data")
+	}
+	initial := r.data[r.pos]
+	r.pos++
+
+	major, info := initial>>5, initial&0x1f
+
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	case info == 31:
+		return 0, 0, fmt.Errorf("indefinite length items are not supported")
+	default:
+		return 0, 0, fmt.Errorf("reserved additional information %d", info)
+	}
+
+	if len(r.data)-r.pos < size {
+		return 0, 0, fmt.Errorf("unexpected end of data")
+	}
+	for _, b := range r.data[r.pos : r.pos+size] {
+		arg = arg<<8 | uint64(b)
+	}
+	r.pos += size
+
+	return major, arg, nil
+}
+
+// int reads an integer or a bignum.
+func (r *cborReader) int() (*big.Int, error) {
+	major, arg, err := r.head()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case major == cborUnsigned:
+		return new(big.Int).SetUint64(arg), nil
+	case major == cborNegative:
+		n := new(big.Int).SetUint64(arg)
+		return n.Not(n), nil
+	case major == cborTag && (arg == cborTagPositiveBignum || arg == cborTagNegativeBignum):
+		b, err := r.byteString()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bignum: %w", err)
+		}
+		n := new(big.Int).SetBytes(b)
+		if arg == cborTagNegativeBignum {
+			n.Not(n)
+		}
+		return n, nil
+	}
+
+	return nil, fmt.Errorf("major type %d with argument %d is not an integer", major, arg)
+}
+
+// byteString reads a definite length byte string.
+func (r *cborReader) byteString() ([]byte, error) {
+	major, arg, err := r.head()
+	if err != nil {
+		return nil, err
+	}
+	if major != cborByteString {
+		return nil, fmt.Errorf("major type %d is not a byte string", major)
+	}
+	if arg > uint64(len(r.data)-r.pos) {
+		return nil, fmt.Errorf("unexpected end of data")
+	}
+
+	b := r.data[r.pos : r.pos+int(arg)]
+	r.pos += int(arg)
+
+	return b, nil
+}
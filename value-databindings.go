@@ -7,7 +7,6 @@ package money
 
 import (
 	"database/sql/driver"
-	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -36,60 +35,106 @@ func (v Value) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON fills the object with data matching the json representation.
 // This will not be called if the JSON field of this value doesn't exist, therefore old data may persist after unmarshalling.
+// Missing amounts and unknown fields are ignored, use JSONFormat with Strict set to reject them.
+//
+// Currencies are looked up in the global Currencies collection, use a Decoder to decode against a different collection.
 func (v *Value) UnmarshalJSON(data []byte) error {
+	amount, cur, err := unmarshalJSON(data, Currencies)
+	if err != nil {
+		return err
+	}
+
+	v.amount, v.currency = amount, cur
+
+	return nil
+}
+
+// unmarshalJSON parses the JSON representation of a value.
+// The unique code of the currency is looked up in the given collection.
+func unmarshalJSON(data []byte, cc *CurrencyCollection) (decimal.Decimal, Currency, error) {
 	d := struct {
 		Amount   decimal.Decimal
 		Currency string
 	}{}
 
 	if err := json.Unmarshal(data, &d); err != nil {
-		return err
+		return decimal.Decimal{}, nil, err
 	}
 
 	var cur Currency
 	if d.Currency != "" {
-		if cur = Currencies.ByUniqueCode(d.Currency); cur == nil {
-			return &ErrorCantFindUniqueCode{d.Currency}
+		if cur = cc.ByUniqueCode(d.Currency); cur == nil {
+			return decimal.Decimal{}, nil, &ErrorCantFindUniqueCode{d.Currency}
 		}
 	}
 
-	v.amount, v.currency = d.Amount, cur
-
-	return nil
+	return d.Amount, cur, nil
 }
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// The value is written in the compact versioned binary format, see UnmarshalBinary.
 func (v Value) MarshalBinary() ([]byte, error) {
-	data1 := []byte{0, 0, 0, 0}
+	var uniqueID int32
 	if v.currency != nil {
-		binary.BigEndian.PutUint32(data1, uint32(v.currency.UniqueID()))
+		uniqueID = v.currency.UniqueID()
 	}
 
-	data2, err := v.amount.MarshalBinary()
-	if err != nil {
-		return nil, err
-	}
-
-	return append(data1, data2...), nil
+	return appendBinaryV1(nil, v.amount, uniqueID), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+//
+// The following formats are detected automatically:
+//   - The compact versioned format, as written by MarshalBinary:
+//     The version byte 0x71, the unique ID of the currency as zig-zag varint, the exponent of the amount as zig-zag varint, followed by the mantissa of the amount.
+//     Mantissas that fit into an int64 are stored as zig-zag varint, larger mantissas are stored as 0x00 byte followed by the big-endian two's complement representation.
+//   - The legacy format, as written by earlier versions of this library:
+//     The unique ID of the currency as 4 byte big-endian signed integer, followed by the binary representation of the decimal amount.
+//     Legacy data of currencies with unique IDs from 0x71000000 to 0x71FFFFFF can't be told apart from the compact format, and is not supported.
+//     These IDs are reserved for the version byte, see ValidateCurrency.
+//
+// Currencies are looked up in the global Currencies collection, use a Decoder to decode against a different collection.
 func (v *Value) UnmarshalBinary(data []byte) error {
-	if len(data) < 4 {
-		return fmt.Errorf("error decoding binary %v: expected at least 4 bytes, got %d", data, len(data))
+	amount, cur, err := unmarshalBinary(data, Currencies)
+	if err != nil {
+		return err
 	}
 
-	if uniqueID := int32(binary.BigEndian.Uint32(data[:4])); uniqueID == 0 {
-		v.currency = nil
+	v.amount, v.currency = amount, cur
+
+	return nil
+}
+
+// unmarshalBinary parses the binary representation of a value in any of the supported formats.
+// The unique ID of the currency is looked up in the given collection.
+func unmarshalBinary(data []byte, cc *CurrencyCollection) (decimal.Decimal, Currency, error) {
+	var amount decimal.Decimal
+	var uniqueID int32
+	var err error
+
+	if len(data) > 0 && data[0] == binaryVersion1 {
+		if amount, uniqueID, err = parseBinaryV1(data); err != nil {
+			// Give a better hint if this is legacy data of a currency with a reserved unique ID.
+			if _, _, legacyErr := parseBinaryLegacy(data); legacyErr == nil {
+				return decimal.Decimal{}, nil, fmt.Errorf("error decoding binary %x: legacy data of currencies with unique IDs from 0x71000000 to 0x71FFFFFF is not supported: %w", data, err)
+			}
+		}
 	} else {
-		cur := Currencies.ByUniqueID(uniqueID)
-		if cur == nil {
-			return &ErrorCantFindUniqueID{uniqueID}
+		amount, uniqueID, err = parseBinaryLegacy(data)
+	}
+	if err != nil {
+		return decimal.Decimal{}, nil, err
+	}
+
+	var cur Currency
+	if uniqueID != 0 {
+		if cur = cc.ByUniqueID(uniqueID); cur == nil {
+			return decimal.Decimal{}, nil, &ErrorCantFindUniqueID{uniqueID}
 		}
-		v.currency = cur
 	}
 
-	return v.amount.UnmarshalBinary(data[4:])
+	return amount, cur, nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
@@ -98,6 +143,8 @@ func (v Value) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
+//
+// Currencies are looked up in the global Currencies collection, use a Decoder to decode against a different collection.
 func (v *Value) UnmarshalText(text []byte) error {
 	amount, cur, err := parse(string(text), Currencies, nil)
 	if err != nil {
@@ -132,56 +179,125 @@ func (v Value) Value() (driver.Value, error) {
 }
 
 // Scan fills the object with data matching the given value from the database.
+//
+// Strings and byte slices in all of the following formats are detected automatically:
+//   - PostgreSQL composite with unique ID like `(-12.34,42170978)`, as written by Value.Value.
+//   - PostgreSQL composite with unique code like `(-12.34,ISO4217-EUR)`, as written by UniqueCodeValue.Value.
+//   - The output of Value.String like `-12.34 ISO4217-EUR` or `-12.34`, as written by StringValue.Value.
+//   - JSON objects like `{"Amount":"-12.34","Currency":"ISO4217-EUR"}`, as written by JSONValue.Value.
+//
+// An empty or NULL currency field of a composite means that there is no currency.
+// Integers and floats are scanned as amount without currency.
+//
+// Currencies are looked up in the global Currencies collection, use a Decoder to decode against a different collection.
 func (v *Value) Scan(value interface{}) error {
-	str, ok := value.(string)
-	if !ok {
-		return fmt.Errorf("incompatible type %T, expected %T", value, str)
+	amount, currency, err := scanValue(value, Currencies)
+	if err != nil {
+		return err
 	}
 
-	trimmed := strings.Trim(str, "()")
+	v.amount, v.currency = amount, currency
 
-	var amountStr, curStr string
-	var currency Currency
+	return nil
+}
 
-	// Parse expression.
-	split := strings.Split(trimmed, ",")
-	switch len(split) {
-	case 1:
-		// String contains an amount string.
-		amountStr = strings.Trim(split[0], " ")
-
-	case 2:
-		// String contains an amount string + unique currency code.
-		amountStr, curStr = strings.Trim(split[0], " "), strings.Trim(split[1], " ")
-
-		if curStr != "" {
-			// Look in global collection for the currency.
-			uniqueID64, err := strconv.ParseInt(curStr, 10, 32)
-			if err != nil {
-				return fmt.Errorf("failed to parse currency ID from database field: %w", err)
-			}
-			uniqueID := int32(uniqueID64)
-			currency = Currencies.ByUniqueID(uniqueID)
+// scanValue parses a value from any of its database representations.
+// The currency is looked up in the given collection.
+func scanValue(value interface{}, cc *CurrencyCollection) (decimal.Decimal, Currency, error) {
+	switch value := value.(type) {
+	case string:
+		return scanString(value, cc)
+	case []byte:
+		return scanString(string(value), cc)
+	case int64:
+		return decimal.NewFromInt(value), nil, nil
+	case float64:
+		return decimal.NewFromFloat(value), nil, nil
+	case nil:
+		return decimal.Decimal{}, nil, fmt.Errorf("can't scan NULL into %T, use NullValue for nullable columns", Value{})
+	}
 
-			// If there is no match, return error.
-			if currency == nil {
-				return &ErrorCantFindUniqueID{uniqueID}
-			}
+	return decimal.Decimal{}, nil, fmt.Errorf("incompatible type %T, expected string or []byte", value)
+}
+
+// scanString parses a value from its database representation.
+// See Value.Scan for the supported formats.
+// The currency is looked up in the given collection.
+func scanString(str string, cc *CurrencyCollection) (decimal.Decimal, Currency, error) {
+	trimmed := strings.TrimSpace(str)
+
+	switch {
+	case strings.HasPrefix(trimmed, "{"):
+		// JSON object.
+		amount, cur, err := unmarshalJSON([]byte(trimmed), cc)
+		if err != nil {
+			return decimal.Decimal{}, nil, fmt.Errorf("failed to unmarshal JSON %q: %w", trimmed, err)
 		}
+		return amount, cur, nil
 
-	default:
-		return fmt.Errorf("input string %q contains too many spaces", str)
+	case !strings.HasPrefix(trimmed, "("):
+		// Amount with optional unique code, like the output of Value.String().
+		amount, cur, err := parse(trimmed, cc, nil)
+		if err != nil {
+			return decimal.Decimal{}, nil, fmt.Errorf("failed to parse %q: %w", trimmed, err)
+		}
+		return amount, cur, nil
+	}
+
+	fields, err := parseComposite(str)
+	if err != nil {
+		return decimal.Decimal{}, nil, err
+	}
+	if len(fields) < 1 || len(fields) > 2 {
+		return decimal.Decimal{}, nil, fmt.Errorf("composite %q has %d fields, expected 1 or 2", str, len(fields))
 	}
 
-	// Parse amount string.
+	if fields[0] == nil {
+		return decimal.Decimal{}, nil, fmt.Errorf("amount of composite %q is NULL", str)
+	}
+	amountStr := strings.TrimSpace(*fields[0])
 	amount, err := decimal.NewFromString(amountStr)
 	if err != nil {
-		return err
+		return decimal.Decimal{}, nil, fmt.Errorf("failed to parse amount %q: %w", amountStr, err)
 	}
 
-	v.amount, v.currency = amount, currency
+	var currency Currency
+	if len(fields) == 2 && fields[1] != nil {
+		if currency, err = scanCurrency(strings.TrimSpace(*fields[1]), cc); err != nil {
+			return decimal.Decimal{}, nil, err
+		}
+	}
 
-	return nil
+	return amount, currency, nil
+}
+
+// scanCurrency returns the currency of the given collection that matches the given unique ID or unique code.
+// An empty string results in no currency.
+func scanCurrency(str string, cc *CurrencyCollection) (Currency, error) {
+	if str == "" {
+		return nil, nil
+	}
+
+	// Everything that looks like a number is a unique ID.
+	if c := str[0]; c == '-' || c == '+' || (c >= '0' && c <= '9') {
+		uniqueID64, err := strconv.ParseInt(str, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse currency ID %q: %w", str, err)
+		}
+		uniqueID := int32(uniqueID64)
+
+		currency := cc.ByUniqueID(uniqueID)
+		if currency == nil {
+			return nil, &ErrorCantFindUniqueID{uniqueID}
+		}
+		return currency, nil
+	}
+
+	currency := cc.ByUniqueCode(str)
+	if currency == nil {
+		return nil, &ErrorCantFindUniqueCode{str}
+	}
+	return currency, nil
 }
 
 // GormDBDataType returns the datatype that a database should use for the field.
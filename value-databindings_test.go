@@ -8,8 +8,12 @@ package money
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/hex"
 	"encoding/json"
+	"math"
 	"testing"
+
+	"github.com/shopspring/decimal"
 )
 
 func TestJSONMarshalling(t *testing.T) {
@@ -84,6 +88,16 @@ func TestBinaryMarshalling(t *testing.T) {
 		MustFromString("3.1415926535897932384626433832795028841971693993751058209749445923078164062862089986280348253421170679 ISO4217-XXX"),
 	}
 
+	expectedMarshalledValues := []string{
+		"71000000",
+		"71c4e99b280000",
+		"710007a9b4de75",
+		"71c4e99b2807a9b4de75",
+		"710007aab4de75",
+		"71b0e79b2807aab4de75",
+		"71eee99b28c701003973eb87e5d7087d0d2b0119208781b470b09e78f6eb1d91ee326cefdb64fa5406ba944ce62eb559eff7",
+	}
+
 	// Marshall values.
 	marshalledValues := make([][]byte, len(values))
 	for i, value := range values {
@@ -93,6 +107,16 @@ func TestBinaryMarshalling(t *testing.T) {
 		}
 	}
 
+	// Check marshalled values.
+	if len(expectedMarshalledValues) != len(marshalledValues) {
+		t.Fatalf("Amount of expected values %d and marshalled values %d is not equal.", len(expectedMarshalledValues), len(marshalledValues))
+	}
+	for i, expected := range expectedMarshalledValues {
+		if expected != hex.EncodeToString(marshalledValues[i]) {
+			t.Errorf("Expected marshalled value %s, got %x", expected, marshalledValues[i])
+		}
+	}
+
 	// Unmarshal values.
 	unmarshalledValues := make([]Value, len(marshalledValues))
 	for i, value := range marshalledValues {
@@ -114,6 +138,111 @@ func TestBinaryMarshalling(t *testing.T) {
 	}
 }
 
+func TestValue_UnmarshalBinary(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Value
+		wantErr bool
+	}{
+		{"1", "71c4e99b2803a313", MustFromString("-12.34 ISO4217-EUR"), false},
+		{"2", "71000000008000000000000000", MustFromString("9223372036854775808"), false},    // Big mantissa.
+		{"3", "710000ffffffffffffffffff01", MustFromString("-9223372036854775808"), false},   // Varint mantissa at the int64 limit.
+		{"4", "71000000ffff7fffffffffffffff", MustFromString("-9223372036854775809"), false}, // Big mantissa with redundant sign extension.
+		{"5", "02837a62fffffffe0304d2", MustFromString("-12.34 ISO4217-EUR"), false},         // Legacy format.
+		{"6", "000000000000000002", MustFromString("0"), false},                              // Legacy format.
+		{"7", "0283799afffffffc03075bcd15", MustFromString("-12345.6789 ISO4217-USD"), true}, // Legacy format with unknown currency.
+		{"8", "0283799a", Value{}, true},                                                     // Legacy format without amount.
+		{"9", "71", Value{}, true},                                                           // Missing currency ID.
+		{"10", "7100", Value{}, true},                                                        // Missing exponent.
+		{"11", "710000", Value{}, true},                                                      // Missing mantissa.
+		{"12", "71000080", Value{}, true},                                                    // Truncated mantissa.
+		{"13", "7100000000", Value{}, true},                                                  // Trailing data.
+		{"14", "7100000001", Value{}, true},                                                  // Trailing data.
+		{"15", "710000000001", Value{}, true},                                                // Big mantissa that fits into a varint.
+		{"16", "7180808080100000", Value{}, true},                                            // Currency ID overflow.
+		{"17", "7100808080801000", Value{}, true},                                            // Exponent overflow.
+		{"18", "710200", Value{}, true},                                                      // Unknown currency.
+		{"19", "71ffffffffffffffffffff0100", Value{}, true},                                  // Varint overflow.
+		{"20", "", Value{}, true},
+		{"21", "71000001000000000002", Value{}, true}, // Legacy format with a reserved unique ID.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			if err != nil {
+				t.Fatalf("hex.DecodeString() failed: %v", err)
+			}
+
+			var got Value
+			err = got.UnmarshalBinary(data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.UnmarshalBinary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !got.Equal(tt.want) {
+				t.Errorf("Value.UnmarshalBinary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func FuzzValue_UnmarshalBinary(f *testing.F) {
+	f.Add([]byte{0x71, 0xc4, 0xe9, 0x9b, 0x28, 0x03, 0xa3, 0x13})
+	f.Add([]byte{0x71, 0x00, 0x00, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+	f.Add([]byte{0x02, 0x83, 0x7a, 0x62, 0xff, 0xff, 0xff, 0xfe, 0x03, 0x04, 0xd2})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var value Value
+		if err := value.UnmarshalBinary(data); err != nil {
+			return
+		}
+
+		// Everything that can be decoded has to survive a roundtrip through the current format.
+		marshalled, err := value.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%v.MarshalBinary() failed: %v", value, err)
+		}
+		var got Value
+		if err := got.UnmarshalBinary(marshalled); err != nil {
+			t.Fatalf("Value.UnmarshalBinary(%x) failed: %v", marshalled, err)
+		}
+		if equal, err := value.EqualDetailed(got); err != nil || !equal {
+			t.Errorf("Binary roundtrip failed. Values %v and %v are not equal", value, got)
+		}
+		if got.Decimal().Exponent() != value.Decimal().Exponent() {
+			t.Errorf("Binary roundtrip changed the exponent from %d to %d", value.Decimal().Exponent(), got.Decimal().Exponent())
+		}
+	})
+}
+
+func FuzzBinaryMarshalling(f *testing.F) {
+	currencies := []Currency{nil, ISO4217Currencies.ByCode("EUR"), ISO4217Currencies.ByCode("JPY")}
+
+	f.Add([]byte{0xfb, 0x2e}, int32(-2), uint8(1))
+	f.Add([]byte{0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, int32(0), uint8(0))
+	f.Add([]byte{}, int32(math.MaxInt32), uint8(2))
+
+	f.Fuzz(func(t *testing.T, mantissa []byte, exponent int32, currencyIndex uint8) {
+		value := FromDecimal(decimal.NewFromBigInt(parseTwosComplement(mantissa), exponent), currencies[int(currencyIndex)%len(currencies)])
+
+		marshalled, err := value.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%v.MarshalBinary() failed: %v", value, err)
+		}
+		var got Value
+		if err := got.UnmarshalBinary(marshalled); err != nil {
+			t.Fatalf("Value.UnmarshalBinary(%x) failed: %v", marshalled, err)
+		}
+		if equal, err := value.EqualDetailed(got); err != nil || !equal {
+			t.Errorf("Binary roundtrip failed. Values %v and %v are not equal", value, got)
+		}
+		if got.Decimal().Exponent() != value.Decimal().Exponent() {
+			t.Errorf("Binary roundtrip changed the exponent from %d to %d", value.Decimal().Exponent(), got.Decimal().Exponent())
+		}
+	})
+}
+
 func TestTextMarshalling(t *testing.T) {
 	values := []Value{
 		MustFromString("0"),
@@ -216,3 +345,259 @@ func TestGobEncoding(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgpackMarshalling(t *testing.T) {
+	values := []Value{
+		MustFromString("0"),
+		MustFromString("0 ISO4217-EUR"),
+		MustFromString("-12345.6789"),
+		MustFromString("-12345.6789 ISO4217-EUR"),
+		MustFromString("12345.6789"),
+		MustFromString("12345.6789 ISO4217-USD"),
+		MustFromString("3.1415926535897932384626433832795028841971693993751058209749445923078164062862089986280348253421170679 ISO4217-XXX"),
+	}
+
+	expectedMarshalledValues := []string{
+		"d7440000000000000000",
+		"d74402837a6200000000",
+		"c70c4400000000fffffffcf8a432eb",
+		"c70c4402837a62fffffffcf8a432eb",
+		"c70c4400000000fffffffc075bcd15",
+		"c70c44028379d8fffffffc075bcd15",
+		"c7324402837a77ffffff9c3973eb87e5d7087d0d2b0119208781b470b09e78f6eb1d91ee326cefdb64fa5406ba944ce62eb559eff7",
+	}
+
+	// Marshall values.
+	marshalledValues := make([][]byte, len(values))
+	for i, value := range values {
+		var err error
+		if marshalledValues[i], err = value.MarshalMsgpack(); err != nil {
+			t.Errorf("%#v.MarshalMsgpack() failed: %v", value, err)
+		}
+	}
+
+	// Check marshalled values.
+	if len(expectedMarshalledValues) != len(marshalledValues) {
+		t.Fatalf("Amount of expected values %d and marshalled values %d is not equal.", len(expectedMarshalledValues), len(marshalledValues))
+	}
+	for i, expected := range expectedMarshalledValues {
+		if expected != hex.EncodeToString(marshalledValues[i]) {
+			t.Errorf("Expected marshalled value %s, got %x", expected, marshalledValues[i])
+		}
+	}
+
+	// Unmarshal values.
+	unmarshalledValues := make([]Value, len(marshalledValues))
+	for i, value := range marshalledValues {
+		if err := unmarshalledValues[i].UnmarshalMsgpack(value); err != nil {
+			t.Errorf("unmarshalledValues[i].UnmarshalMsgpack(%x) failed: %v", value, err)
+		}
+	}
+
+	// Check roundtrip.
+	if len(values) != len(unmarshalledValues) {
+		t.Fatalf("Amount of values %d and unmarshalled values %d is not equal", len(values), len(unmarshalledValues))
+	}
+	for i, value := range values {
+		if equal, err := value.EqualDetailed(unmarshalledValues[i]); err != nil {
+			t.Errorf("MessagePack roundtrip failed: %v", err)
+		} else if !equal {
+			t.Errorf("MessagePack roundtrip failed. Values %q and %q are not equal", value, unmarshalledValues[i])
+		}
+	}
+}
+
+func TestCBORMarshalling(t *testing.T) {
+	values := []Value{
+		MustFromString("0"),
+		MustFromString("0 ISO4217-EUR"),
+		MustFromString("-12345.6789"),
+		MustFromString("-12345.6789 ISO4217-EUR"),
+		MustFromString("12345.6789"),
+		MustFromString("12345.6789 ISO4217-USD"),
+		MustFromString("3.1415926535897932384626433832795028841971693993751058209749445923078164062862089986280348253421170679 ISO4217-XXX"),
+	}
+
+	expectedMarshalledValues := []string{
+		"82c482000000",
+		"82c48200001a02837a62",
+		"82c482233a075bcd1400",
+		"82c482233a075bcd141a02837a62",
+		"82c482231a075bcd1500",
+		"82c482231a075bcd151a028379d8",
+		"82c4823863c2582a3973eb87e5d7087d0d2b0119208781b470b09e78f6eb1d91ee326cefdb64fa5406ba944ce62eb559eff71a02837a77",
+	}
+
+	// Marshall values.
+	marshalledValues := make([][]byte, len(values))
+	for i, value := range values {
+		var err error
+		if marshalledValues[i], err = value.MarshalCBOR(); err != nil {
+			t.Errorf("%#v.MarshalCBOR() failed: %v", value, err)
+		}
+	}
+
+	// Check marshalled values.
+	if len(expectedMarshalledValues) != len(marshalledValues) {
+		t.Fatalf("Amount of expected values %d and marshalled values %d is not equal.", len(expectedMarshalledValues), len(marshalledValues))
+	}
+	for i, expected := range expectedMarshalledValues {
+		if expected != hex.EncodeToString(marshalledValues[i]) {
+			t.Errorf("Expected marshalled value %s, got %x", expected, marshalledValues[i])
+		}
+	}
+
+	// Unmarshal values.
+	unmarshalledValues := make([]Value, len(marshalledValues))
+	for i, value := range marshalledValues {
+		if err := unmarshalledValues[i].UnmarshalCBOR(value); err != nil {
+			t.Errorf("unmarshalledValues[i].UnmarshalCBOR(%x) failed: %v", value, err)
+		}
+	}
+
+	// Check roundtrip.
+	if len(values) != len(unmarshalledValues) {
+		t.Fatalf("Amount of values %d and unmarshalled values %d is not equal", len(values), len(unmarshalledValues))
+	}
+	for i, value := range values {
+		if equal, err := value.EqualDetailed(unmarshalledValues[i]); err != nil {
+			t.Errorf("CBOR roundtrip failed: %v", err)
+		} else if !equal {
+			t.Errorf("CBOR roundtrip failed. Values %q and %q are not equal", value, unmarshalledValues[i])
+		}
+	}
+}
+
+func TestValue_UnmarshalMsgpack(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Value
+		wantErr bool
+	}{
+		{"1", "d74402837a62fffffffe", MustFromString("0 ISO4217-EUR"), false},
+		{"2", "d84402837a62fffffffefffffffffffffb2e", MustFromString("-12.34 ISO4217-EUR"), false}, // Mantissa with redundant sign extension.
+		{"3", "c7084402837a62fffffffe", MustFromString("0 ISO4217-EUR"), false},                    // Non shortest ext format.
+		{"4", "c800094400000000fffffffe80", MustFromString("-1.28"), false},
+		{"5", "c9000000094400000000fffffffe7f", MustFromString("1.27"), false},
+		{"6", "d74502837a62fffffffe", Value{}, true},    // Wrong extension type.
+		{"7", "d64402837a62", Value{}, true},            // Payload too short.
+		{"8", "d74400000001fffffffe", Value{}, true},    // Unknown currency.
+		{"9", "d74402837a62ffffff", Value{}, true},      // Truncated.
+		{"10", "d74402837a62fffffffe00", Value{}, true}, // Trailing data.
+		{"11", "c9ffffffff44", Value{}, true},           // Huge length.
+		{"12", "c0", Value{}, true},                     // Nil.
+		{"13", "", Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			if err != nil {
+				t.Fatalf("hex.DecodeString() failed: %v", err)
+			}
+
+			var got Value
+			err = got.UnmarshalMsgpack(data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.UnmarshalMsgpack() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.UnmarshalMsgpack() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_UnmarshalCBOR(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Value
+		wantErr bool
+	}{
+		{"1", "82c482213904d11a02837a62", MustFromString("-12.34 ISO4217-EUR"), false},
+		{"2", "c482213904d1", MustFromString("-12.34"), false},                                                     // Plain decimal fraction.
+		{"3", "82c4821b00000000000000013b00000000000004d11a02837a62", MustFromString("-12340 ISO4217-EUR"), false}, // Non shortest integers.
+		{"4", "82c48221c34204d100", MustFromString("-12.34"), false},                                               // Bignum mantissa.
+		{"5", "82c48221c24204d200", MustFromString("12.34"), false},                                                // Bignum mantissa.
+		{"6", "82c48221c24000", MustFromString("0"), false},                                                        // Empty bignum.
+		{"7", "82c482213904d11a00000001", Value{}, true},                                                           // Unknown currency.
+		{"8", "82c482213904d11b0000000100000000", Value{}, true},                                                   // Currency ID overflow.
+		{"9", "82c4821a800000000000", Value{}, true},                                                               // Exponent overflow.
+		{"10", "82c4823a800000000000", Value{}, true},                                                              // Exponent underflow.
+		{"11", "82c482c24101000000", Value{}, true},                                                                // Bignum exponent.
+		{"12", "82c5822104d100", Value{}, true},                                                                    // Bigfloat.
+		{"13", "83c482213904d10000", Value{}, true},                                                                // Wrong array length.
+		{"14", "82c483213904d10000", Value{}, true},                                                                // Wrong decimal fraction length.
+		{"15", "9fc482213904d100ff", Value{}, true},                                                                // Indefinite length.
+		{"16", "82c48221c35f4204d1ff00", Value{}, true},                                                            // Indefinite byte string.
+		{"17", "82c48221c34a04d100", Value{}, true},                                                                // Truncated bignum.
+		{"18", "82c482213904d1", Value{}, true},                                                                    // Missing currency.
+		{"19", "82c482213904d10000", Value{}, true},                                                                // Trailing data.
+		{"20", "82c482213904d11c", Value{}, true},                                                                  // Reserved additional information.
+		{"21", "82c482216130", Value{}, true},                                                                      // Text string mantissa.
+		{"22", "82c48221c26130", Value{}, true},                                                                    // Bignum with text string.
+		{"23", "f6", Value{}, true},                                                                                // Null.
+		{"24", "", Value{}, true},
+		{"25", "82c482213904", Value{}, true}, // Truncated integer.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			if err != nil {
+				t.Fatalf("hex.DecodeString() failed: %v", err)
+			}
+
+			var got Value
+			err = got.UnmarshalCBOR(data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.UnmarshalCBOR() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.UnmarshalCBOR() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    Value
+		wantErr bool
+	}{
+		{"1", "(-12.34,42170978)", MustFromString("-12.34 ISO4217-EUR"), false},
+		{"2", []byte("(-12.34,42170978)"), MustFromString("-12.34 ISO4217-EUR"), false},
+		{"3", "(-12.34,)", MustFromString("-12.34"), false},
+		{"4", "(-12.34)", MustFromString("-12.34"), false},
+		{"5", "-12.34", MustFromString("-12.34"), false},
+		{"6", ` ( "-12.34" , "42170978" ) `, MustFromString("-12.34 ISO4217-EUR"), false},
+		{"7", `(-12.34,"")`, MustFromString("-12.34"), false},
+		{"8", int64(-12), MustFromString("-12"), false},
+		{"9", float64(-12.5), MustFromString("-12.5"), false},
+		{"10", nil, Value{}, true},
+		{"11", "(-12.34,42170978,1)", Value{}, true},
+		{"12", "(,42170978)", Value{}, true},
+		{"13", "(-12.34,abc)", Value{}, true},
+		{"14", "(-12.34,1)", Value{}, true},
+		{"15", "(-12.34,42170978", Value{}, true},
+		{"16", "abc", Value{}, true},
+		{"17", true, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Value
+			err := got.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
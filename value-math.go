@@ -8,6 +8,8 @@ package money
 import (
 	"fmt"
 	"math"
+	"math/big"
+	"sort"
 
 	"github.com/shopspring/decimal"
 )
@@ -102,6 +104,20 @@ func (v Value) LessThanOrEqualDetailed(comp Value) (bool, error) {
 	return v.amount.LessThanOrEqual(comp.amount), nil
 }
 
+// Cmp compares the monetary value with another value and returns:
+//
+//	-1 if v <  comp
+//	 0 if v == comp
+//	+1 if v >  comp
+//
+// If the currency differs between the two values, the result is always 0 and an error is returned.
+func (v Value) Cmp(comp Value) (int, error) {
+	if v.currency != comp.currency {
+		return 0, &ErrorDifferentCurrencies{v.currency, comp.currency}
+	}
+	return v.amount.Cmp(comp.amount), nil
+}
+
 // Decimal returns the value as a shopspring/decimal number.
 func (v Value) Decimal() decimal.Decimal {
 	return v.amount
@@ -118,6 +134,46 @@ func (v Value) InexactFloat64() float64 {
 	return f
 }
 
+// MinorUnitsBigInt returns the value as an integer amount of the currency's smallest unit.
+// This is the format that most payment processors use, e.g. 1234 for 12.34 EUR.
+//
+// In case the value has no currency, the currency has no smallest unit, or the value is not a multiple of the smallest unit, this will return an error.
+//
+//	MustFromString("12.34 ISO4217-EUR").MinorUnitsBigInt()  // Returns 1234.
+//	MustFromString("1234 ISO4217-JPY").MinorUnitsBigInt()   // Returns 1234.
+//	MustFromString("12.345 ISO4217-EUR").MinorUnitsBigInt() // Returns an error, as the value is not a multiple of the smallest unit (0.01).
+//	MustFromString("12.34").MinorUnitsBigInt()              // Returns an error, as there is no smallest unit.
+func (v Value) MinorUnitsBigInt() (*big.Int, error) {
+	var smallestUnit Value // Default value is a smallest unit of 0. Which means that there is no smallest unit.
+	if v.currency != nil {
+		smallestUnit = v.currency.SmallestUnit()
+	}
+
+	q, err := v.quoSmallestUnit(smallestUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.BigInt(), nil
+}
+
+// MinorUnitsInt64 returns the value as an integer amount of the currency's smallest unit.
+// This is the format that most payment processors use, e.g. 1234 for 12.34 EUR.
+//
+// In addition to the errors of MinorUnitsBigInt(), this will return an error if the result doesn't fit into an int64.
+func (v Value) MinorUnitsInt64() (int64, error) {
+	i, err := v.MinorUnitsBigInt()
+	if err != nil {
+		return 0, err
+	}
+
+	if !i.IsInt64() {
+		return 0, fmt.Errorf("amount of minor units %s overflows int64", i)
+	}
+
+	return i.Int64(), nil
+}
+
 // Add returns v + v2 as a new value.
 // It will not mutate either v or v2.
 //
@@ -243,6 +299,25 @@ func (v Value) IsZero() bool {
 	return v.Sign() == 0
 }
 
+// quoSmallestUnit returns the number of smallest units that v consists of.
+// The result has the same sign as v.
+// If v is not a multiple of smallestUnit, an error will be returned.
+func (v Value) quoSmallestUnit(smallestUnit Value) (decimal.Decimal, error) {
+	if smallestUnit.Sign() <= 0 {
+		return decimal.Zero, fmt.Errorf("smallest unit with %s is outside the allowed range", smallestUnit)
+	}
+	if smallestUnit.currency != v.currency {
+		return decimal.Zero, &ErrorDifferentCurrencies{v.currency, smallestUnit.currency}
+	}
+
+	q, r := v.amount.QuoRem(smallestUnit.amount, 0)
+	if !r.IsZero() {
+		return decimal.Zero, fmt.Errorf("value is not a multiple of the smallest unit %s", smallestUnit)
+	}
+
+	return q, nil
+}
+
 // SplitWithSmallestUnit returns the value of v split into a list of n values.
 // If the value can't be split evenly, the remainder will be distributed round-robin amongst the parts.
 // The resulting values will always be multiple of smallestUnit, if that's not possible an error will be returned.
@@ -254,24 +329,18 @@ func (v Value) SplitWithSmallestUnit(n int, smallestUnit Value) ([]Value, error)
 	if n <= 0 {
 		return nil, fmt.Errorf("number of parts must not be negative")
 	}
-	if smallestUnit.Sign() <= 0 {
-		return nil, fmt.Errorf("smallest unit with %s is outside the allowed range", smallestUnit)
-	}
-	if smallestUnit.currency != v.currency {
-		return nil, &ErrorDifferentCurrencies{v.currency, smallestUnit.currency}
+
+	// Get amount of smallest units that have to be distributed.
+	q, err := v.quoSmallestUnit(smallestUnit)
+	if err != nil {
+		return nil, err
 	}
 
 	// Negate smallest unit if the value is negative.
 	// This way we will always have positive amounts of smallest units.
 	smallestUnitSigned := smallestUnit.Decimal()
 	if v.IsNegative() {
-		smallestUnitSigned = smallestUnitSigned.Neg()
-	}
-
-	// Get amount of smallest units that have to be distributed.
-	q, r := v.amount.QuoRem(smallestUnitSigned, 0)
-	if !r.IsZero() {
-		return nil, fmt.Errorf("value is not a multiple of the smallest unit %s", smallestUnit)
+		smallestUnitSigned, q = smallestUnitSigned.Neg(), q.Neg()
 	}
 
 	// Get amount of smallest units per part.
@@ -300,6 +369,88 @@ func (v Value) SplitWithSmallestUnit(n int, smallestUnit Value) ([]Value, error)
 	return values, nil
 }
 
+// AllocateWithSmallestUnit returns the value of v split into a list of values proportional to the given weights.
+// The resulting values will always be multiple of smallestUnit, if that's not possible an error will be returned.
+// If the value can't be split exactly, the remaining smallest units are distributed one by one to the parts with the largest remainders.
+// In case of ties the earlier parts are preferred, so equal weights result in the same distribution as SplitWithSmallestUnit.
+//
+// The weights must not be negative, and their sum must be positive.
+//
+//	MustFromString("100 ISO4217-EUR").AllocateWithSmallestUnit([]decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(2)}, MustFromString("0.01 ISO4217-EUR")) // Returns the two EUR values `33.33`, `66.67`.
+//	MustFromString("-0.05").AllocateWithSmallestUnit([]decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(1)}, MustFromString("0.01"))                      // Returns the two values `-0.03`, `-0.02`.
+func (v Value) AllocateWithSmallestUnit(weights []decimal.Decimal, smallestUnit Value) ([]Value, error) {
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("list of weights is empty")
+	}
+
+	totalWeight := decimal.Zero
+	for _, weight := range weights {
+		if weight.IsNegative() {
+			return nil, fmt.Errorf("weight %s is negative", weight)
+		}
+		totalWeight = totalWeight.Add(weight)
+	}
+	if !totalWeight.IsPositive() {
+		return nil, fmt.Errorf("sum of weights must be positive")
+	}
+
+	// Get amount of smallest units that have to be distributed.
+	q, err := v.quoSmallestUnit(smallestUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Negate smallest unit if the value is negative.
+	// This way we will always have positive amounts of smallest units.
+	smallestUnitSigned := smallestUnit.Decimal()
+	if v.IsNegative() {
+		smallestUnitSigned, q = smallestUnitSigned.Neg(), q.Neg()
+	}
+
+	// Get the amount of smallest units per part, rounded down.
+	// All remainders share the same divisor, so they can be compared directly.
+	units, remainders := make([]decimal.Decimal, len(weights)), make([]decimal.Decimal, len(weights))
+	distributed := decimal.Zero
+	for i, weight := range weights {
+		units[i], remainders[i] = q.Mul(weight).QuoRem(totalWeight, 0)
+		distributed = distributed.Add(units[i])
+	}
+
+	// Distribute the remaining smallest units to the parts with the largest remainders.
+	// There are less remaining smallest units than parts, so this fits into an int.
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]].GreaterThan(remainders[order[j]]) })
+	for _, i := range order[:int(q.Sub(distributed).IntPart())] {
+		units[i] = units[i].Add(decimal.NewFromInt(1))
+	}
+
+	// Build list of values.
+	values := make([]Value, len(weights))
+	for i := range values {
+		values[i] = Value{amount: units[i].Mul(smallestUnitSigned), currency: v.currency}
+	}
+
+	return values, nil
+}
+
+// Allocate returns the value of v split into a list of values proportional to the given weights.
+// If the value can't be split exactly, the remaining smallest units are distributed one by one to the parts with the largest remainders.
+// The smallest unit is determined by the currency of the given value.
+//
+//	MustFromString("100 ISO4217-EUR").Allocate([]decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(2)}) // Returns the two EUR values `33.33`, `66.67`.
+//	MustFromString("100").Allocate([]decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(2)})             // Returns an error, as there is no smallest unit.
+func (v Value) Allocate(weights []decimal.Decimal) ([]Value, error) {
+	var smallestUnit Value // Default value is a smallest unit of 0. Which means that there is no smallest unit.
+	if v.currency != nil {
+		smallestUnit = v.currency.SmallestUnit()
+	}
+
+	return v.AllocateWithSmallestUnit(weights, smallestUnit)
+}
+
 // SplitWithDecimals returns the value of v split into a list of n values.
 // If the value can't be split evenly, the remainder will be distributed round-robin amongst the parts.
 // The smallest unit that the value is split into is calculated by 10^(-decimalPlaces).
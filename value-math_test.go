@@ -190,6 +190,37 @@ func TestValue_LessThanOrEqual(t *testing.T) {
 	}
 }
 
+func TestValue_Cmp(t *testing.T) {
+	type args struct {
+		comp Value
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{"1", MustFromString("-1234567.89"), args{MustFromString("-1234567.90")}, 1, false},
+		{"2", MustFromString("-1234567.89"), args{MustFromString("-1234567.89")}, 0, false},
+		{"3", MustFromString("-1234567.89"), args{MustFromString("-1234567.88")}, -1, false},
+		{"4", MustFromString("-1234567.89 ISO4217-EUR"), args{MustFromString("-1234567.89 ISO4217-USD")}, 0, true},
+		{"5", MustFromString("-1234567.89 ISO4217-EUR"), args{MustFromString("-1234567.89")}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.Cmp(tt.args.comp)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.Cmp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Value.Cmp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestValue_Float64(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -574,6 +605,69 @@ func TestValue_SplitWithSmallestUnit_Random(t *testing.T) {
 	}
 }
 
+func TestValue_AllocateWithSmallestUnit(t *testing.T) {
+	type args struct {
+		weights      []decimal.Decimal
+		smallestUnit Value
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    []Value
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("100 ISO4217-EUR"), args{[]decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(2)}, MustFromString("0.01 ISO4217-EUR")}, []Value{MustFromString("33.33 ISO4217-EUR"), MustFromString("66.67 ISO4217-EUR")}, false},
+		{"comment_2", MustFromString("-0.05"), args{[]decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(1)}, MustFromString("0.01")}, []Value{MustFromString("-0.03"), MustFromString("-0.02")}, false},
+		{"1", MustFromString("-11.11"), args{[]decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.NewFromInt(1)}, MustFromString("0.01")}, []Value{MustFromString("-3.71"), MustFromString("-3.7"), MustFromString("-3.7")}, false},
+		{"2", MustFromString("1"), args{[]decimal.Decimal{decimal.New(1, -1), decimal.New(3, -1), decimal.New(6, -1)}, MustFromString("0.01")}, []Value{MustFromString("0.1"), MustFromString("0.3"), MustFromString("0.6")}, false},
+		{"3", MustFromString("0.1"), args{[]decimal.Decimal{decimal.NewFromInt(0), decimal.NewFromInt(1), decimal.NewFromInt(3)}, MustFromString("0.01")}, []Value{MustFromString("0"), MustFromString("0.03"), MustFromString("0.07")}, false},
+		{"4", MustFromString("0.1"), args{[]decimal.Decimal{decimal.NewFromInt(-1), decimal.NewFromInt(2)}, MustFromString("0.01")}, []Value{}, true},
+		{"5", MustFromString("0.1"), args{[]decimal.Decimal{decimal.NewFromInt(0)}, MustFromString("0.01")}, []Value{}, true},
+		{"6", MustFromString("0.1"), args{nil, MustFromString("0.01")}, []Value{}, true},
+		{"7", MustFromString("0.105"), args{[]decimal.Decimal{decimal.NewFromInt(1)}, MustFromString("0.01")}, []Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.AllocateWithSmallestUnit(tt.args.weights, tt.args.smallestUnit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.AllocateWithSmallestUnit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("Value.AllocateWithSmallestUnit() returned unexpected number of elements %v, want %v", len(got), len(tt.want))
+				return
+			}
+			for i, part := range got {
+				if !part.Equal(tt.want[i]) {
+					t.Errorf("Value.AllocateWithSmallestUnit() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestValue_AllocateWithSmallestUnit_Random(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		v := FromDecimal(decimal.New(rand.Int63n(2000000)-1000000, -2), nil)
+		weights := make([]decimal.Decimal, rand.Intn(10)+1)
+		for j := range weights {
+			weights[j] = decimal.New(rand.Int63n(1000), -int32(rand.Intn(3)))
+		}
+		weights[0] = weights[0].Add(decimal.NewFromInt(1)) // Make sure the sum of weights is positive.
+
+		parts, err := v.AllocateWithSmallestUnit(weights, MustFromString("0.01"))
+		if err != nil {
+			t.Fatalf("%v.AllocateWithSmallestUnit(%v, 0.01) failed: %v", v, weights, err)
+		}
+
+		if sum := MustSum(parts[0], parts[1:]...); !sum.Equal(v) {
+			t.Errorf("Sum of allocated parts %v is %v, want %v", parts, sum, v)
+		}
+	}
+}
+
 func TestValue_SplitWithDecimals(t *testing.T) {
 	type args struct {
 		n             int
@@ -734,3 +828,61 @@ func TestValue_Split(t *testing.T) {
 		})
 	}
 }
+
+func TestValue_MinorUnitsInt64(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       Value
+		want    int64
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("12.34 ISO4217-EUR"), 1234, false},
+		{"comment_2", MustFromString("1234 ISO4217-JPY"), 1234, false},
+		{"comment_3", MustFromString("12.345 ISO4217-EUR"), 0, true},
+		{"comment_4", MustFromString("12.34"), 0, true},
+		{"1", MustFromString("-12.34 ISO4217-EUR"), -1234, false},
+		{"2", MustFromString("0 ISO4217-EUR"), 0, false},
+		{"3", MustFromString("92233720368547758.07 ISO4217-EUR"), 9223372036854775807, false},
+		{"4", MustFromString("-92233720368547758.08 ISO4217-EUR"), -9223372036854775808, false},
+		{"5", MustFromString("92233720368547758.08 ISO4217-EUR"), 0, true},
+		{"6", MustFromString("-92233720368547758.09 ISO4217-EUR"), 0, true},
+		{"7", MustFromString("12.34 ISO4217-XAU"), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.MinorUnitsInt64()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.MinorUnitsInt64() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Value.MinorUnitsInt64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_MinorUnitsBigInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       Value
+		want    string
+		wantErr bool
+	}{
+		{"1", MustFromString("12.34 ISO4217-EUR"), "1234", false},
+		{"2", MustFromString("-123456789012345678901234567890.12 ISO4217-EUR"), "-12345678901234567890123456789012", false},
+		{"3", MustFromString("12.345 ISO4217-EUR"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.MinorUnitsBigInt()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.MinorUnitsBigInt() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != nil && got.String() != tt.want {
+				t.Errorf("Value.MinorUnitsBigInt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// MsgpackExtType is the MessagePack extension type that values are encoded as.
+//
+// The payload of the extension consists of:
+//   - The unique ID of the currency as 4 byte big-endian signed integer, or 0 if there is no currency.
+//   - The exponent of the amount as 4 byte big-endian signed integer.
+//   - The mantissa of the amount as big-endian two's complement integer of arbitrary length, or no bytes if the mantissa is zero.
+//
+// The amount is mantissa * 10^exponent.
+const MsgpackExtType int8 = 68
+
+// MarshalMsgpack returns the value as complete MessagePack extension object, including the extension header.
+//
+// This matches the Marshaler interface of most MessagePack libraries.
+// Use MarshalMsgpackExt if a library expects only the payload of a registered extension type.
+func (v Value) MarshalMsgpack() ([]byte, error) {
+	payload, err := v.MarshalMsgpackExt()
+	if err != nil {
+		return nil, err
+	}
+
+	return appendMsgpackExt(nil, MsgpackExtType, payload), nil
+}
+
+// UnmarshalMsgpack fills the object with data from the given MessagePack extension object, as written by MarshalMsgpack.
+//
+// Currencies are looked up in the global Currencies collection, use a Decoder to decode against a different collection.
+func (v *Value) UnmarshalMsgpack(data []byte) error {
+	amount, cur, err := unmarshalMsgpack(data, Currencies)
+	if err != nil {
+		return err
+	}
+
+	v.amount, v.currency = amount, cur
+
+	return nil
+}
+
+// MarshalMsgpackExt returns the payload of the MessagePack extension, without the extension header.
+// See MsgpackExtType for the format.
+func (v Value) MarshalMsgpackExt() ([]byte, error) {
+	data := make([]byte, 8, 16)
+	if v.currency != nil {
+		binary.BigEndian.PutUint32(data[0:4], uint32(v.currency.UniqueID()))
+	}
+	binary.BigEndian.PutUint32(data[4:8], uint32(v.amount.Exponent()))
+
+	return appendTwosComplement(data, v.amount.Coefficient()), nil
+}
+
+// UnmarshalMsgpackExt fills the object with data from the given MessagePack extension payload, as written by MarshalMsgpackExt.
+//
+// Currencies are looked up in the global Currencies collection, use a Decoder to decode against a different collection.
+func (v *Value) UnmarshalMsgpackExt(data []byte) error {
+	amount, cur, err := unmarshalMsgpackExt(data, Currencies)
+	if err != nil {
+		return err
+	}
+
+	v.amount, v.currency = amount, cur
+
+	return nil
+}
+
+// unmarshalMsgpack parses a MessagePack extension object.
+// The unique ID of the currency is looked up in the given collection.
+func unmarshalMsgpack(data []byte, cc *CurrencyCollection) (decimal.Decimal, Currency, error) {
+	extType, payload, err := parseMsgpackExt(data)
+	if err != nil {
+		return decimal.Decimal{}, nil, fmt.Errorf("error decoding MessagePack %x: %w", data, err)
+	}
+	if extType != MsgpackExtType {
+		return decimal.Decimal{}, nil, fmt.Errorf("error decoding MessagePack %x: extension type %d, expected %d", data, extType, MsgpackExtType)
+	}
+
+	return unmarshalMsgpackExt(payload, cc)
+}
+
+// unmarshalMsgpackExt parses the payload of a MessagePack extension.
+// The unique ID of the currency is looked up in the given collection.
+func unmarshalMsgpackExt(data []byte, cc *CurrencyCollection) (decimal.Decimal, Currency, error) {
+	if len(data) < 8 {
+		return decimal.Decimal{}, nil, fmt.Errorf("error decoding MessagePack extension %x: expected at least 8 bytes, got %d", data, len(data))
+	}
+
+	var cur Currency
+	if uniqueID := int32(binary.BigEndian.Uint32(data[0:4])); uniqueID != 0 {
+		if cur = cc.ByUniqueID(uniqueID); cur == nil {
+			return decimal.Decimal{}, nil, &ErrorCantFindUniqueID{uniqueID}
+		}
+	}
+
+	exponent := int32(binary.BigEndian.Uint32(data[4:8]))
+	mantissa := parseTwosComplement(data[8:])
+
+	return decimal.NewFromBigInt(mantissa, exponent), cur, nil
+}
+
+// appendMsgpackExt appends a MessagePack extension object with the given type and payload to buf.
+// The shortest possible extension family format is used.
+func appendMsgpackExt(buf []byte, extType int8, payload []byte) []byte {
+	switch l := len(payload); {
+	case l == 1:
+		buf = append(buf, 0xd4)
+	case l == 2:
+		buf = append(buf, 0xd5)
+	case l == 4:
+		buf = append(buf, 0xd6)
+	case l == 8:
+		buf = append(buf, 0xd7)
+	case l == 16:
+		buf = append(buf, 0xd8)
+	case l <= 0xff:
+		buf = append(buf, 0xc7, byte(l))
+	case l <= 0xffff:
+		buf = append(buf, 0xc8, byte(l>>8), byte(l))
+	default:
+		buf = append(buf, 0xc9, byte(l>>24), byte(l>>16), byte(l>>8), byte(l))
+	}
+
+	buf = append(buf, byte(extType))
+	return append(buf, payload...)
+}
+
+// parseMsgpackExt returns the type and payload of the MessagePack extension object in data.
+// data must contain exactly one extension object.
+func parseMsgpackExt(data []byte) (int8, []byte, error) {
+	if len(data) < 1 {
+		return 0, nil, fmt.Errorf("unexpected end of data")
+	}
+
+	var length, headerLen int
+	switch data[0] {
+	case 0xd4:
+		length, headerLen = 1, 1
+	case 0xd5:
+		length, headerLen = 2, 1
+	case 0xd6:
+		length, headerLen = 4, 1
+	case 0xd7:
+		length, headerLen = 8, 1
+	case 0xd8:
+		length, headerLen = 16, 1
+	case 0xc7:
+		if len(data) < 2 {
+			return 0, nil, fmt.Errorf("unexpected end of data")
+		}
+		length, headerLen = int(data[1]), 2
+	case 0xc8:
+		if len(data) < 3 {
+			return 0, nil, fmt.Errorf("unexpected end of data")
+		}
+		length, headerLen = int(binary.BigEndian.Uint16(data[1:3])), 3
+	case 0xc9:
+		if len(data) < 5 {
+			return 0, nil, fmt.Errorf("unexpected end of data")
+		}
+		l := binary.BigEndian.Uint32(data[1:5])
+		if uint64(l) > uint64(len(data)) {
+			return 0, nil, fmt.Errorf("unexpected end of data")
+		}
+		length, headerLen = int(l), 5
+	default:
+		return 0, nil, fmt.Errorf("format 0x%02x is not an extension", data[0])
+	}
+
+	// Header, type and payload.
+	if len(data) < headerLen+1+length {
+		return 0, nil, fmt.Errorf("unexpected end of data")
+	}
+	if len(data) > headerLen+1+length {
+		return 0, nil, fmt.Errorf("%d trailing bytes", len(data)-(headerLen+1+length))
+	}
+
+	return int8(data[headerLen]), data[headerLen+1:], nil
+}
+
+// appendTwosComplement appends the shortest big-endian two's complement representation of x to buf.
+// Nothing is appended if x is zero.
+func appendTwosComplement(buf []byte, x *big.Int) []byte {
+	switch x.Sign() {
+	case 0:
+		return buf
+	case 1:
+		b := x.Bytes()
+		if b[0]&0x80 != 0 {
+			buf = append(buf, 0)
+		}
+		return append(buf, b...)
+	}
+
+	// Negative numbers are represented as 2^(8*n) + x, where n is the smallest number of bytes that has the sign bit set.
+	n := new(big.Int).Not(x).BitLen()/8 + 1
+	b := new(big.Int).Lsh(big.NewInt(1), uint(8*n))
+	b.Add(b, x)
+
+	res := make([]byte, n)
+	return append(buf, b.FillBytes(res)...)
+}
+
+// parseTwosComplement returns the big-endian two's complement integer in data.
+// Empty data results in zero.
+func parseTwosComplement(data []byte) *big.Int {
+	x := new(big.Int).SetBytes(data)
+	if len(data) > 0 && data[0]&0x80 != 0 {
+		x.Sub(x, new(big.Int).Lsh(big.NewInt(1), uint(8*len(data))))
+	}
+
+	return x
+}
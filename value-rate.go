@@ -0,0 +1,70 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"fmt"
+)
+
+// ApplyPercent returns v * r as a new value.
+// The result is rounded to a multiple of the smallest unit of the currency by using the given rounding mode.
+//
+//	MustFromString("100 ISO4217-EUR").ApplyPercent(MustParseRate("19%"), RoundHalfUp)  // Returns 19 ISO4217-EUR.
+//	MustFromString("9.99 ISO4217-EUR").ApplyPercent(MustParseRate("19%"), RoundHalfUp) // Returns 1.9 ISO4217-EUR.
+//	MustFromString("9.99 ISO4217-EUR").ApplyPercent(MustParseRate("19%"), RoundDown)   // Returns 1.89 ISO4217-EUR.
+//	MustFromString("9.99").ApplyPercent(MustParseRate("19%"), RoundHalfUp)             // Returns an error, as there is no smallest unit.
+func (v Value) ApplyPercent(r Rate, mode RoundingMode) (Value, error) {
+	product, err := v.Mul(r.Value())
+	if err != nil {
+		return Value{}, err
+	}
+
+	return product.Round(mode)
+}
+
+// Discount returns v reduced by the given rate as a new value, e.g. the value with "15% off".
+// The discount is rounded to a multiple of the smallest unit of the currency by using the given rounding mode, before it is subtracted.
+//
+//	MustFromString("9.99 ISO4217-EUR").Discount(MustParseRate("15%"), RoundHalfUp) // Returns 8.49 ISO4217-EUR, as the discount is rounded to 1.5 ISO4217-EUR.
+func (v Value) Discount(r Rate, mode RoundingMode) (Value, error) {
+	discount, err := v.ApplyPercent(r, mode)
+	if err != nil {
+		return Value{}, err
+	}
+
+	return v.Sub(discount)
+}
+
+// Markup returns v increased by the given rate as a new value, e.g. the value with "19% VAT" added.
+// The markup is rounded to a multiple of the smallest unit of the currency by using the given rounding mode, before it is added.
+//
+//	MustFromString("9.99 ISO4217-EUR").Markup(MustParseRate("19%"), RoundHalfUp) // Returns 11.89 ISO4217-EUR, as the markup is rounded to 1.9 ISO4217-EUR.
+func (v Value) Markup(r Rate, mode RoundingMode) (Value, error) {
+	markup, err := v.ApplyPercent(r, mode)
+	if err != nil {
+		return Value{}, err
+	}
+
+	return v.Add(markup)
+}
+
+// PercentOf returns the rate of v relative to total, e.g. "what percent of the total is this line".
+// The currencies of both values must match, and total must not be zero.
+//
+// The result is exact if possible, otherwise it is rounded to decimal.DivisionPrecision decimal places of the ratio.
+//
+//	MustFromString("25 ISO4217-EUR").PercentOf(MustFromString("200 ISO4217-EUR")) // Returns 12.5%.
+//	MustFromString("25 ISO4217-EUR").PercentOf(MustFromString("200"))             // Returns an error.
+func (v Value) PercentOf(total Value) (Rate, error) {
+	if v.currency != total.currency {
+		return Rate{}, &ErrorDifferentCurrencies{v.currency, total.currency}
+	}
+	if total.IsZero() {
+		return Rate{}, fmt.Errorf("can't get the rate relative to a total of zero")
+	}
+
+	return Rate{ratio: v.amount.Div(total.amount)}, nil
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"testing"
+)
+
+func TestValue_ApplyPercent(t *testing.T) {
+	type args struct {
+		r    Rate
+		mode RoundingMode
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("100 ISO4217-EUR"), args{MustParseRate("19%"), RoundHalfUp}, MustFromString("19 ISO4217-EUR"), false},
+		{"comment_2", MustFromString("9.99 ISO4217-EUR"), args{MustParseRate("19%"), RoundHalfUp}, MustFromString("1.9 ISO4217-EUR"), false},
+		{"comment_3", MustFromString("9.99 ISO4217-EUR"), args{MustParseRate("19%"), RoundDown}, MustFromString("1.89 ISO4217-EUR"), false},
+		{"comment_4", MustFromString("9.99"), args{MustParseRate("19%"), RoundHalfUp}, Value{}, true},
+		{"1", MustFromString("-9.99 ISO4217-EUR"), args{MustParseRate("19%"), RoundHalfUp}, MustFromString("-1.9 ISO4217-EUR"), false},
+		{"2", MustFromString("999 ISO4217-JPY"), args{MustParseRate("1900bp"), RoundHalfUp}, MustFromString("190 ISO4217-JPY"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.ApplyPercent(tt.args.r, tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.ApplyPercent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.ApplyPercent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_Discount(t *testing.T) {
+	type args struct {
+		r    Rate
+		mode RoundingMode
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("9.99 ISO4217-EUR"), args{MustParseRate("15%"), RoundHalfUp}, MustFromString("8.49 ISO4217-EUR"), false},
+		{"1", MustFromString("9.99 ISO4217-EUR"), args{MustParseRate("100%"), RoundHalfUp}, MustFromString("0 ISO4217-EUR"), false},
+		{"2", MustFromString("9.99"), args{MustParseRate("15%"), RoundHalfUp}, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.Discount(tt.args.r, tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.Discount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.Discount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_Markup(t *testing.T) {
+	type args struct {
+		r    Rate
+		mode RoundingMode
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("9.99 ISO4217-EUR"), args{MustParseRate("19%"), RoundHalfUp}, MustFromString("11.89 ISO4217-EUR"), false},
+		{"1", MustFromString("9.99 ISO4217-EUR"), args{MustParseRate("0%"), RoundHalfUp}, MustFromString("9.99 ISO4217-EUR"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.Markup(tt.args.r, tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.Markup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.Markup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_PercentOf(t *testing.T) {
+	type args struct {
+		total Value
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    Rate
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("25 ISO4217-EUR"), args{MustFromString("200 ISO4217-EUR")}, MustParseRate("12.5%"), false},
+		{"comment_2", MustFromString("25 ISO4217-EUR"), args{MustFromString("200")}, Rate{}, true},
+		{"1", MustFromString("25 ISO4217-EUR"), args{MustFromString("0 ISO4217-EUR")}, Rate{}, true},
+		{"2", MustFromString("1"), args{MustFromString("3")}, MustParseRate("0.3333333333333333"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.PercentOf(tt.args.total)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.PercentOf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.PercentOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
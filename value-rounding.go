@@ -0,0 +1,197 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode defines how a value is rounded to a multiple of a smallest unit.
+type RoundingMode int
+
+const (
+	RoundHalfUp   RoundingMode = iota // Round to the nearest multiple, ties are rounded away from zero. E.g. 2.5 -> 3, -2.5 -> -3. This is the default.
+	RoundHalfDown                     // Round to the nearest multiple, ties are rounded towards zero. E.g. 2.5 -> 2, -2.5 -> -2.
+	RoundHalfEven                     // Round to the nearest multiple, ties are rounded to the even multiple (banker's rounding). E.g. 2.5 -> 2, 3.5 -> 4.
+	RoundUp                           // Round away from zero. E.g. 2.1 -> 3, -2.1 -> -3.
+	RoundDown                         // Round towards zero (truncate). E.g. 2.9 -> 2, -2.9 -> -2.
+	RoundCeiling                      // Round towards positive infinity. E.g. 2.1 -> 3, -2.9 -> -2.
+	RoundFloor                        // Round towards negative infinity. E.g. 2.9 -> 2, -2.1 -> -3.
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfUp:
+		return "RoundHalfUp"
+	case RoundHalfDown:
+		return "RoundHalfDown"
+	case RoundHalfEven:
+		return "RoundHalfEven"
+	case RoundUp:
+		return "RoundUp"
+	case RoundDown:
+		return "RoundDown"
+	case RoundCeiling:
+		return "RoundCeiling"
+	case RoundFloor:
+		return "RoundFloor"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(m))
+}
+
+// roundQuo returns the integer quotient x / d rounded with the given rounding mode.
+// d must be positive.
+//
+// This is exact, no matter if x / d can be represented as a finite decimal number or not.
+func roundQuo(x, d decimal.Decimal, mode RoundingMode) (decimal.Decimal, error) {
+	q, r := x.QuoRem(d, 0) // q is truncated towards zero, r has the same sign as x.
+	if r.IsZero() {
+		return q, nil
+	}
+
+	// The direction to round away from zero.
+	away := decimal.NewFromInt(int64(r.Sign()))
+
+	// Compare the remainder with the half of the divisor, this is the same as 2*|r| compared with d.
+	half := r.Abs().Add(r.Abs()).Cmp(d)
+
+	switch mode {
+	case RoundHalfUp:
+		if half >= 0 {
+			return q.Add(away), nil
+		}
+	case RoundHalfDown:
+		if half > 0 {
+			return q.Add(away), nil
+		}
+	case RoundHalfEven:
+		if half > 0 || (half == 0 && !q.Mod(decimal.NewFromInt(2)).IsZero()) {
+			return q.Add(away), nil
+		}
+	case RoundUp:
+		return q.Add(away), nil
+	case RoundDown:
+	case RoundCeiling:
+		if r.IsPositive() {
+			return q.Add(away), nil
+		}
+	case RoundFloor:
+		if r.IsNegative() {
+			return q.Add(away), nil
+		}
+	default:
+		return decimal.Zero, fmt.Errorf("unknown rounding mode %v", mode)
+	}
+
+	return q, nil
+}
+
+// RoundWithSmallestUnit returns the value of v rounded to a multiple of smallestUnit by using the given rounding mode.
+//
+//	MustFromString("-11.115").RoundWithSmallestUnit(MustFromString("0.01"), RoundHalfUp)                         // Returns `-11.12`.
+//	MustFromString("-11.115 ISO4217-EUR").RoundWithSmallestUnit(MustFromString("0.01 ISO4217-EUR"), RoundDown)   // Returns `-11.11 ISO4217-EUR`.
+//	MustFromString("11.13 ISO4217-CHF").RoundWithSmallestUnit(MustFromString("0.05 ISO4217-CHF"), RoundHalfEven) // Returns `11.15 ISO4217-CHF`.
+func (v Value) RoundWithSmallestUnit(smallestUnit Value, mode RoundingMode) (Value, error) {
+	if smallestUnit.Sign() <= 0 {
+		return Value{}, fmt.Errorf("smallest unit with %s is outside the allowed range", smallestUnit)
+	}
+	if smallestUnit.currency != v.currency {
+		return Value{}, &ErrorDifferentCurrencies{v.currency, smallestUnit.currency}
+	}
+
+	q, err := roundQuo(v.amount, smallestUnit.amount, mode)
+	if err != nil {
+		return Value{}, err
+	}
+
+	return Value{amount: q.Mul(smallestUnit.amount), currency: v.currency}, nil
+}
+
+// RoundWithDecimals returns the value of v rounded to the given number of decimal places by using the given rounding mode.
+// The smallest unit that the value is rounded to is calculated by 10^(-decimalPlaces).
+//
+//	MustFromString("-11.115 ISO4217-EUR").RoundWithDecimals(2, RoundHalfUp) // Returns `-11.12 ISO4217-EUR`.
+//	MustFromString("-11.115 ISO4217-EUR").RoundWithDecimals(1, RoundHalfUp) // Returns `-11.1 ISO4217-EUR`.
+func (v Value) RoundWithDecimals(decimalPlaces int, mode RoundingMode) (Value, error) {
+	if decimalPlaces <= math.MinInt32 || decimalPlaces > math.MaxInt32 { // Exclude MinInt32 from valid range, as we want to invert the number.
+		return Value{}, fmt.Errorf("decimal places (%d) is outside the allowed range", decimalPlaces)
+	}
+
+	smallestUnit := FromDecimal(decimal.New(1, -int32(decimalPlaces)), v.currency)
+
+	return v.RoundWithSmallestUnit(smallestUnit, mode)
+}
+
+// Round returns the value of v rounded to a multiple of the smallest unit of its currency by using the given rounding mode.
+//
+//	MustFromString("-11.115 ISO4217-EUR").Round(RoundHalfUp) // Returns `-11.12 ISO4217-EUR`.
+//	MustFromString("-11.5 ISO4217-VND").Round(RoundHalfEven) // Returns `-12 ISO4217-VND`.
+//	MustFromString("-11.115").Round(RoundHalfUp)             // Returns an error, as there is no smallest unit.
+func (v Value) Round(mode RoundingMode) (Value, error) {
+	var smallestUnit Value // Default value is a smallest unit of 0. Which means that there is no smallest unit.
+	if v.currency != nil {
+		smallestUnit = v.currency.SmallestUnit()
+	}
+
+	return v.RoundWithSmallestUnit(smallestUnit, mode)
+}
+
+// DivRoundWithSmallestUnit returns v / divisor as a new value.
+// The result is rounded to a multiple of smallestUnit by using the given rounding mode.
+// This is exact, even if the quotient can't be represented as a finite decimal number.
+//
+// The divisor must not have a currency, and must not be zero.
+//
+//	MustFromString("10 ISO4217-EUR").DivRoundWithSmallestUnit(MustFromString("3"), MustFromString("0.01 ISO4217-EUR"), RoundHalfUp) // Returns 3.33 ISO4217-EUR.
+//	MustFromString("10 ISO4217-EUR").DivRoundWithSmallestUnit(MustFromString("3"), MustFromString("0.01 ISO4217-EUR"), RoundUp)     // Returns 3.34 ISO4217-EUR.
+func (v Value) DivRoundWithSmallestUnit(divisor Value, smallestUnit Value, mode RoundingMode) (Value, error) {
+	if divisor.currency != nil {
+		return Value{}, fmt.Errorf("can't divide by a value with currency %s", helperCurrencyUniqueCode(divisor.currency))
+	}
+	if divisor.IsZero() {
+		return Value{}, fmt.Errorf("can't divide by zero")
+	}
+	if smallestUnit.Sign() <= 0 {
+		return Value{}, fmt.Errorf("smallest unit with %s is outside the allowed range", smallestUnit)
+	}
+	if smallestUnit.currency != v.currency {
+		return Value{}, &ErrorDifferentCurrencies{v.currency, smallestUnit.currency}
+	}
+
+	// Make sure the divisor is positive.
+	amount, divisorAmount := v.amount, divisor.amount
+	if divisorAmount.IsNegative() {
+		amount, divisorAmount = amount.Neg(), divisorAmount.Neg()
+	}
+
+	// Divide by divisor * smallestUnit, so we get the rounded number of smallest units.
+	q, err := roundQuo(amount, divisorAmount.Mul(smallestUnit.amount), mode)
+	if err != nil {
+		return Value{}, err
+	}
+
+	return Value{amount: q.Mul(smallestUnit.amount), currency: v.currency}, nil
+}
+
+// DivRound returns v / divisor as a new value.
+// The result is rounded to a multiple of the smallest unit of the currency by using the given rounding mode.
+// This is exact, even if the quotient can't be represented as a finite decimal number.
+//
+// The divisor must not have a currency, and must not be zero.
+//
+//	MustFromString("10 ISO4217-EUR").DivRound(MustFromString("3"), RoundHalfUp) // Returns 3.33 ISO4217-EUR.
+//	MustFromString("10").DivRound(MustFromString("3"), RoundHalfUp)             // Returns an error, as there is no smallest unit.
+func (v Value) DivRound(divisor Value, mode RoundingMode) (Value, error) {
+	var smallestUnit Value // Default value is a smallest unit of 0. Which means that there is no smallest unit.
+	if v.currency != nil {
+		smallestUnit = v.currency.SmallestUnit()
+	}
+
+	return v.DivRoundWithSmallestUnit(divisor, smallestUnit, mode)
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"testing"
+)
+
+func TestValue_RoundWithSmallestUnit(t *testing.T) {
+	type args struct {
+		smallestUnit Value
+		mode         RoundingMode
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("-11.115"), args{MustFromString("0.01"), RoundHalfUp}, MustFromString("-11.12"), false},
+		{"comment_2", MustFromString("-11.115 ISO4217-EUR"), args{MustFromString("0.01 ISO4217-EUR"), RoundDown}, MustFromString("-11.11 ISO4217-EUR"), false},
+		{"comment_3", MustFromString("11.13 ISO4217-CHF"), args{MustFromString("0.05 ISO4217-CHF"), RoundHalfEven}, MustFromString("11.15 ISO4217-CHF"), false},
+		{"1", MustFromString("2.5"), args{MustFromString("1"), RoundHalfUp}, MustFromString("3"), false},
+		{"2", MustFromString("-2.5"), args{MustFromString("1"), RoundHalfUp}, MustFromString("-3"), false},
+		{"3", MustFromString("2.5"), args{MustFromString("1"), RoundHalfDown}, MustFromString("2"), false},
+		{"4", MustFromString("-2.5"), args{MustFromString("1"), RoundHalfDown}, MustFromString("-2"), false},
+		{"5", MustFromString("2.5"), args{MustFromString("1"), RoundHalfEven}, MustFromString("2"), false},
+		{"6", MustFromString("3.5"), args{MustFromString("1"), RoundHalfEven}, MustFromString("4"), false},
+		{"7", MustFromString("-3.5"), args{MustFromString("1"), RoundHalfEven}, MustFromString("-4"), false},
+		{"8", MustFromString("2.1"), args{MustFromString("1"), RoundUp}, MustFromString("3"), false},
+		{"9", MustFromString("-2.1"), args{MustFromString("1"), RoundUp}, MustFromString("-3"), false},
+		{"10", MustFromString("2.9"), args{MustFromString("1"), RoundDown}, MustFromString("2"), false},
+		{"11", MustFromString("-2.9"), args{MustFromString("1"), RoundDown}, MustFromString("-2"), false},
+		{"12", MustFromString("2.1"), args{MustFromString("1"), RoundCeiling}, MustFromString("3"), false},
+		{"13", MustFromString("-2.9"), args{MustFromString("1"), RoundCeiling}, MustFromString("-2"), false},
+		{"14", MustFromString("2.9"), args{MustFromString("1"), RoundFloor}, MustFromString("2"), false},
+		{"15", MustFromString("-2.1"), args{MustFromString("1"), RoundFloor}, MustFromString("-3"), false},
+		{"16", MustFromString("2.6"), args{MustFromString("1"), RoundHalfDown}, MustFromString("3"), false},
+		{"17", MustFromString("2"), args{MustFromString("1"), RoundUp}, MustFromString("2"), false},
+		{"18", MustFromString("1234"), args{MustFromString("500"), RoundHalfUp}, MustFromString("1000"), false},
+		{"19", MustFromString("1 ISO4217-EUR"), args{MustFromString("0.01"), RoundHalfUp}, Value{}, true},
+		{"20", MustFromString("1"), args{MustFromString("0"), RoundHalfUp}, Value{}, true},
+		{"21", MustFromString("1.5"), args{MustFromString("1"), RoundingMode(-1)}, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.RoundWithSmallestUnit(tt.args.smallestUnit, tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.RoundWithSmallestUnit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.RoundWithSmallestUnit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_RoundWithDecimals(t *testing.T) {
+	type args struct {
+		decimalPlaces int
+		mode          RoundingMode
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("-11.115 ISO4217-EUR"), args{2, RoundHalfUp}, MustFromString("-11.12 ISO4217-EUR"), false},
+		{"comment_2", MustFromString("-11.115 ISO4217-EUR"), args{1, RoundHalfUp}, MustFromString("-11.1 ISO4217-EUR"), false},
+		{"1", MustFromString("-1155"), args{-1, RoundHalfEven}, MustFromString("-1160"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.RoundWithDecimals(tt.args.decimalPlaces, tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.RoundWithDecimals() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.RoundWithDecimals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_Round(t *testing.T) {
+	type args struct {
+		mode RoundingMode
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("-11.115 ISO4217-EUR"), args{RoundHalfUp}, MustFromString("-11.12 ISO4217-EUR"), false},
+		{"comment_2", MustFromString("-11.5 ISO4217-VND"), args{RoundHalfEven}, MustFromString("-12 ISO4217-VND"), false},
+		{"comment_3", MustFromString("-11.115"), args{RoundHalfUp}, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.Round(tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.Round() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.Round() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_DivRoundWithSmallestUnit(t *testing.T) {
+	type args struct {
+		divisor      Value
+		smallestUnit Value
+		mode         RoundingMode
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("10 ISO4217-EUR"), args{MustFromString("3"), MustFromString("0.01 ISO4217-EUR"), RoundHalfUp}, MustFromString("3.33 ISO4217-EUR"), false},
+		{"comment_2", MustFromString("10 ISO4217-EUR"), args{MustFromString("3"), MustFromString("0.01 ISO4217-EUR"), RoundUp}, MustFromString("3.34 ISO4217-EUR"), false},
+		{"1", MustFromString("10 ISO4217-EUR"), args{MustFromString("-3"), MustFromString("0.01 ISO4217-EUR"), RoundFloor}, MustFromString("-3.34 ISO4217-EUR"), false},
+		{"2", MustFromString("-0.05"), args{MustFromString("2"), MustFromString("0.01"), RoundHalfEven}, MustFromString("-0.02"), false},
+		{"3", MustFromString("1.19"), args{MustFromString("1.19"), MustFromString("0.01"), RoundHalfUp}, MustFromString("1"), false},
+		{"4", MustFromString("10 ISO4217-EUR"), args{MustFromString("3 ISO4217-EUR"), MustFromString("0.01 ISO4217-EUR"), RoundHalfUp}, Value{}, true},
+		{"5", MustFromString("10 ISO4217-EUR"), args{MustFromString("0"), MustFromString("0.01 ISO4217-EUR"), RoundHalfUp}, Value{}, true},
+		{"6", MustFromString("10 ISO4217-EUR"), args{MustFromString("3"), MustFromString("0.01"), RoundHalfUp}, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.DivRoundWithSmallestUnit(tt.args.divisor, tt.args.smallestUnit, tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.DivRoundWithSmallestUnit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.DivRoundWithSmallestUnit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_DivRound(t *testing.T) {
+	type args struct {
+		divisor Value
+		mode    RoundingMode
+	}
+	tests := []struct {
+		name    string
+		v       Value
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", MustFromString("10 ISO4217-EUR"), args{MustFromString("3"), RoundHalfUp}, MustFromString("3.33 ISO4217-EUR"), false},
+		{"comment_2", MustFromString("10"), args{MustFromString("3"), RoundHalfUp}, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.DivRound(tt.args.divisor, tt.args.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value.DivRound() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Value.DivRound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package money
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/shopspring/decimal"
@@ -131,6 +132,49 @@ func FromInt32(i int32, cur Currency) Value {
 	return Value{amount: decimal.NewFromInt32(i), currency: cur}
 }
 
+// FromMinorUnitsBigInt returns a value object from the given amount of minor units and currency.
+// The amount of minor units is multiplied by the smallest unit of the currency, e.g. 1234 EUR cents will result in 12.34 EUR.
+//
+// In case the currency is nil or has no smallest unit, this will return an error.
+func FromMinorUnitsBigInt(i *big.Int, cur Currency) (Value, error) {
+	if cur == nil {
+		return Value{}, fmt.Errorf("can't use minor units without currency")
+	}
+
+	smallestUnit := cur.SmallestUnit()
+	if smallestUnit.Sign() <= 0 {
+		return Value{}, fmt.Errorf("currency %s has no smallest unit", helperCurrencyUniqueCode(cur))
+	}
+
+	return Value{amount: decimal.NewFromBigInt(i, 0).Mul(smallestUnit.amount), currency: cur}, nil
+}
+
+// FromMinorUnits returns a value object from the given amount of minor units and currency.
+// The amount of minor units is multiplied by the smallest unit of the currency, e.g. 1234 EUR cents will result in 12.34 EUR.
+//
+// In case the currency is nil or has no smallest unit, this will return an error.
+//
+//	FromMinorUnits(1234, ISO4217Currencies.ByCode("EUR")) // Returns 12.34 EUR.
+//	FromMinorUnits(1234, ISO4217Currencies.ByCode("JPY")) // Returns 1234 JPY.
+//	FromMinorUnits(1234, nil)                             // Returns an error, as there is no smallest unit.
+func FromMinorUnits(i int64, cur Currency) (Value, error) {
+	return FromMinorUnitsBigInt(big.NewInt(i), cur)
+}
+
+// MustFromMinorUnits returns a value object from the given amount of minor units and currency.
+//
+// In case of an error, this will panic.
+//
+// For examples, see FromMinorUnits().
+func MustFromMinorUnits(i int64, cur Currency) Value {
+	v, err := FromMinorUnits(i, cur)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create monetary value: %v", err))
+	}
+
+	return v
+}
+
 // String returns the monetary value as a "Amount UniqueCode" pair.
 // This is locale independent.
 func (v Value) String() string {
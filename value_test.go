@@ -6,6 +6,7 @@
 package money
 
 import (
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -45,13 +46,14 @@ var (
 	testCurrencyCollision3 Currency = &testCurrency{"Baz", "FOZ", "BAZ", "⟊", "⟊", 4, 1, decimal.New(1, -2), false} // Collides with testCurrency1 on its unique ID.
 	testCurrencyCollision4 Currency = &testCurrency{"Baz", "FOZ", "BAZ", "˥", "˥", 1, 5, decimal.New(1, -2), false} // Collides with testCurrency1 on its numeric code.
 
-	testCurrencyIllegal1 Currency = &testCurrency{"Bar", "FOO", "BAR", "|", "|", 1, 0, decimal.New(1, -2), false} // Variant of testCurrency1 that contains an illegal unique ID.
-	testCurrencyIllegal2 Currency = &testCurrency{"Bar", "FOO", "Bar", "|", "|", 1, 1, decimal.New(1, -2), false} // Variant of testCurrency1 that contains an illegal code.
-	testCurrencyIllegal3 Currency = &testCurrency{"Bar", "Foo", "BAR", "|", "|", 1, 1, decimal.New(1, -2), false} // Variant of testCurrency1 that contains an illegal standard string.
-	testCurrencyIllegal4 Currency = &testCurrency{"Bar", "FOO", "BAR", "|", "|", 1, 1, decimal.New(-1, 0), false} // Variant of testCurrency1 that contains an illegal smallest unit value.
-	testCurrencyIllegal5 Currency = &testCurrency{"Bar", "FOO", "BAR", "|", "|", 1, 1, decimal.New(1, -1), true}  // Variant of testCurrency1 that contains an illegal smallest unit value.
-	testCurrencyIllegal6 Currency = &testCurrency{"Bar", "FOO", "BAR", "|", "", 1, 1, decimal.New(1, -2), false}  // Variant of testCurrency1 that contains an illegal symbol combination.
-	testCurrencyIllegal7 Currency = &testCurrency{"Bar", "FOO", "BAR", "", "|", 1, 1, decimal.New(1, -2), false}  // Variant of testCurrency1 that contains an illegal symbol combination.
+	testCurrencyIllegal1 Currency = &testCurrency{"Bar", "FOO", "BAR", "|", "|", 1, 0, decimal.New(1, -2), false}          // Variant of testCurrency1 that contains an illegal unique ID.
+	testCurrencyIllegal2 Currency = &testCurrency{"Bar", "FOO", "Bar", "|", "|", 1, 1, decimal.New(1, -2), false}          // Variant of testCurrency1 that contains an illegal code.
+	testCurrencyIllegal3 Currency = &testCurrency{"Bar", "Foo", "BAR", "|", "|", 1, 1, decimal.New(1, -2), false}          // Variant of testCurrency1 that contains an illegal standard string.
+	testCurrencyIllegal4 Currency = &testCurrency{"Bar", "FOO", "BAR", "|", "|", 1, 1, decimal.New(-1, 0), false}          // Variant of testCurrency1 that contains an illegal smallest unit value.
+	testCurrencyIllegal5 Currency = &testCurrency{"Bar", "FOO", "BAR", "|", "|", 1, 1, decimal.New(1, -1), true}           // Variant of testCurrency1 that contains an illegal smallest unit value.
+	testCurrencyIllegal6 Currency = &testCurrency{"Bar", "FOO", "BAR", "|", "", 1, 1, decimal.New(1, -2), false}           // Variant of testCurrency1 that contains an illegal symbol combination.
+	testCurrencyIllegal7 Currency = &testCurrency{"Bar", "FOO", "BAR", "", "|", 1, 1, decimal.New(1, -2), false}           // Variant of testCurrency1 that contains an illegal symbol combination.
+	testCurrencyIllegal8 Currency = &testCurrency{"Bar", "FOO", "BAR", "|", "|", 1, 0x71000000, decimal.New(1, -2), false} // Variant of testCurrency1 that contains a unique ID reserved for the binary format (0x71000000 to 0x71FFFFFF).
 )
 
 func TestFooBarCurrencies(t *testing.T) {
@@ -79,6 +81,7 @@ func TestValidateCurrency(t *testing.T) {
 		testCurrencyIllegal5,
 		testCurrencyIllegal6,
 		testCurrencyIllegal7,
+		testCurrencyIllegal8,
 	}
 
 	for _, currency := range currencies {
@@ -86,6 +89,13 @@ func TestValidateCurrency(t *testing.T) {
 			t.Errorf("ValidateCurrency() failed to validate currency %s. Expected error", helperCurrencyUniqueCode(currency))
 		}
 	}
+
+	// Only the unique IDs 0x71000000 to 0x71FFFFFF are reserved for the binary format.
+	for _, uniqueID := range []int32{0x70FFFFFF, 0x72000000, -0x71000000} {
+		if err := ValidateCurrency(&testCurrency{"Bar", "FOO", "BAR", "|", "|", 1, uniqueID, decimal.New(1, -2), false}); err != nil {
+			t.Errorf("ValidateCurrency() with unique ID %#x failed: %v", uniqueID, err)
+		}
+	}
 }
 
 func TestFromString(t *testing.T) {
@@ -151,3 +161,36 @@ func TestFromStringAndCurrency(t *testing.T) {
 		})
 	}
 }
+
+func TestFromMinorUnits(t *testing.T) {
+	type args struct {
+		i   int64
+		cur Currency
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Value
+		wantErr bool
+	}{
+		{"comment_1", args{1234, ISO4217Currencies.ByCode("EUR")}, MustFromString("12.34 ISO4217-EUR"), false},
+		{"comment_2", args{1234, ISO4217Currencies.ByCode("JPY")}, MustFromString("1234 ISO4217-JPY"), false},
+		{"comment_3", args{1234, nil}, Value{}, true},
+		{"1", args{-1234, ISO4217Currencies.ByCode("EUR")}, MustFromString("-12.34 ISO4217-EUR"), false},
+		{"2", args{math.MaxInt64, ISO4217Currencies.ByCode("EUR")}, MustFromString("92233720368547758.07 ISO4217-EUR"), false},
+		{"3", args{1234, ISO4217Currencies.ByCode("XAU")}, Value{}, true},
+		{"4", args{1234, testCurrency1}, MustFromStringAndCurrency("12.34", testCurrency1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromMinorUnits(tt.args.i, tt.args.cur)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FromMinorUnits() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("FromMinorUnits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
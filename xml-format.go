@@ -0,0 +1,297 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// XMLCurrency defines how the currency of a value is represented in XML.
+type XMLCurrency int
+
+const (
+	XMLCurrencyCode       XMLCurrency = iota // XMLCurrencyCode represents the currency by its ISO 4217 code, e.g. "EUR". This is the default.
+	XMLCurrencyUniqueCode                    // XMLCurrencyUniqueCode represents the currency by its unique code, e.g. "ISO4217-EUR".
+)
+
+// XMLFormat describes an XML representation of monetary values.
+// The amount is stored as character data of the element, the currency is stored in an attribute of the element.
+// The zero value describes the representation that is used by ISO 20022 messages, and by Value.MarshalXML:
+//
+//	XMLFormat{}                                                          // <Amt Ccy="EUR">12.34</Amt>
+//	XMLFormat{CurrencyAttr: "currency", Currency: XMLCurrencyUniqueCode} // <Amt currency="ISO4217-EUR">12.34</Amt>
+//
+// Values without currency are represented without currency attribute.
+// When used as attribute, see MarshalAttr, the value is represented as "Amount Currency", e.g. Amt="12.34 EUR".
+//
+// For compatibility with data that was written before Value implemented the XML interfaces, the text representation of Value is read as well.
+// This is the case for elements without currency attribute like <Amt>12.34 ISO4217-EUR</Amt>, and for attributes like Amt="12.34 ISO4217-EUR".
+type XMLFormat struct {
+	CurrencyAttr string              // CurrencyAttr is the name of the currency attribute. Defaults to "Ccy".
+	Currency     XMLCurrency         // Currency defines the representation of the currency.
+	Currencies   *CurrencyCollection // Currencies is the collection that currencies are looked up in. Defaults to ISO4217Currencies for codes, and to the global Currencies for unique codes.
+}
+
+// currencyAttr returns the name of the currency attribute.
+func (f XMLFormat) currencyAttr() string {
+	if f.CurrencyAttr == "" {
+		return "Ccy"
+	}
+	return f.CurrencyAttr
+}
+
+// currencies returns the collection that currencies are looked up in.
+func (f XMLFormat) currencies() *CurrencyCollection {
+	switch {
+	case f.Currencies != nil:
+		return f.Currencies
+	case f.Currency == XMLCurrencyCode:
+		return ISO4217Currencies
+	}
+	return Currencies
+}
+
+// currencyString returns the representation of the given currency, or an empty string if there is no currency.
+// Currencies that can't be looked up by their representation result in an error.
+func (f XMLFormat) currencyString(cur Currency) (string, error) {
+	switch {
+	case cur == nil:
+		return "", nil
+	case f.Currency == XMLCurrencyUniqueCode:
+		return cur.UniqueCode(), nil
+	}
+
+	// Codes are only unique within a single standard, so make sure the code resolves to the same currency.
+	if f.currencies().ByCode(cur.Code()) != cur {
+		return "", fmt.Errorf("currency %q can't be represented by its code %q", cur.UniqueCode(), cur.Code())
+	}
+	return cur.Code(), nil
+}
+
+// lookupCurrency returns the currency that matches the given representation.
+// An empty string results in no currency.
+func (f XMLFormat) lookupCurrency(str string) (Currency, error) {
+	if str == "" {
+		return nil, nil
+	}
+
+	cc := f.currencies()
+	if f.Currency == XMLCurrencyCode {
+		if cur := cc.ByCode(str); cur != nil {
+			return cur, nil
+		}
+		return nil, &ErrorCantFindCode{str}
+	}
+
+	if cur := cc.ByUniqueCode(str); cur != nil {
+		return cur, nil
+	}
+	return nil, &ErrorCantFindUniqueCode{str}
+}
+
+// EncodeElement writes v as XML element with the given start element to e.
+// The currency attribute is added to the attributes of start.
+func (f XMLFormat) EncodeElement(e *xml.Encoder, start xml.StartElement, v Value) error {
+	currency, err := f.currencyString(v.currency)
+	if err != nil {
+		return err
+	}
+
+	if currency != "" {
+		start.Attr = append(start.Attr[:len(start.Attr):len(start.Attr)], xml.Attr{Name: xml.Name{Local: f.currencyAttr()}, Value: currency})
+	}
+
+	return e.EncodeElement(v.amount.String(), start)
+}
+
+// DecodeElement reads the value of the XML element with the given start element from d.
+// The currency attribute is matched by its local name, namespaces are ignored.
+func (f XMLFormat) DecodeElement(d *xml.Decoder, start xml.StartElement) (Value, error) {
+	var amountStr string
+	if err := d.DecodeElement(&amountStr, &start); err != nil {
+		return Value{}, err
+	}
+
+	var currencyStr string
+	for _, attr := range start.Attr {
+		if attr.Name.Local == f.currencyAttr() {
+			currencyStr = strings.TrimSpace(attr.Value)
+			break
+		}
+	}
+
+	amountStr = strings.TrimSpace(amountStr)
+	if currencyStr == "" && strings.ContainsAny(amountStr, " \t\n\r") {
+		return f.parseLegacy(amountStr)
+	}
+
+	return f.parse(amountStr, currencyStr)
+}
+
+// MarshalAttr returns v as XML attribute with the given name.
+// The attribute contains the amount and the currency separated by a space, e.g. "12.34 EUR".
+func (f XMLFormat) MarshalAttr(name xml.Name, v Value) (xml.Attr, error) {
+	currency, err := f.currencyString(v.currency)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	if currency != "" {
+		return xml.Attr{Name: name, Value: v.amount.String() + " " + currency}, nil
+	}
+	return xml.Attr{Name: name, Value: v.amount.String()}, nil
+}
+
+// UnmarshalAttr returns the value of the given XML attribute, as written by MarshalAttr.
+func (f XMLFormat) UnmarshalAttr(attr xml.Attr) (Value, error) {
+	fields := strings.Fields(attr.Value)
+	switch len(fields) {
+	case 1:
+		return f.parse(fields[0], "")
+	case 2:
+		// Codes can't contain "-", but unique codes always do.
+		if f.Currency == XMLCurrencyCode && strings.Contains(fields[1], "-") {
+			return f.parseLegacy(strings.TrimSpace(attr.Value))
+		}
+		return f.parse(fields[0], fields[1])
+	}
+
+	return Value{}, fmt.Errorf("attribute %s=%q is not of the form \"Amount Currency\"", attr.Name.Local, attr.Value)
+}
+
+// parse returns the value of the given amount and currency representation.
+func (f XMLFormat) parse(amountStr, currencyStr string) (Value, error) {
+	if amountStr == "" {
+		return Value{}, fmt.Errorf("missing amount")
+	}
+
+	cur, err := f.lookupCurrency(currencyStr)
+	if err != nil {
+		return Value{}, err
+	}
+
+	amount, err := decimal.NewFromString(amountStr)
+	if err != nil {
+		return Value{}, fmt.Errorf("failed to parse amount %q: %w", amountStr, err)
+	}
+
+	return Value{amount: amount, currency: cur}, nil
+}
+
+// parseLegacy returns the value of the given text representation of Value, e.g. "12.34 ISO4217-EUR".
+// This is how encoding/xml wrote values before Value implemented the XML interfaces.
+// Currencies are looked up in the collection of the format, and otherwise in the global Currencies collection.
+func (f XMLFormat) parseLegacy(str string) (Value, error) {
+	cc := f.Currencies
+	if cc == nil {
+		cc = Currencies
+	}
+
+	amount, cur, err := parse(str, cc, nil)
+	if err != nil {
+		return Value{}, fmt.Errorf("failed to parse text %q: %w", str, err)
+	}
+
+	return Value{amount: amount, currency: cur}, nil
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// The value is written in the representation of the zero XMLFormat, e.g. <Amt Ccy="EUR">12.34</Amt>.
+// Use XMLFormatValue for other representations.
+func (v Value) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return XMLFormat{}.EncodeElement(e, start, v)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// The value is read in the representation of the zero XMLFormat, e.g. <Amt Ccy="EUR">12.34</Amt>.
+// Use XMLFormatValue for other representations.
+func (v *Value) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	res, err := XMLFormat{}.DecodeElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	*v = res
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// The attribute contains the amount and the ISO 4217 code, e.g. Amt="12.34 EUR".
+func (v Value) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return XMLFormat{}.MarshalAttr(name, v)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (v *Value) UnmarshalXMLAttr(attr xml.Attr) error {
+	res, err := XMLFormat{}.UnmarshalAttr(attr)
+	if err != nil {
+		return err
+	}
+
+	*v = res
+	return nil
+}
+
+// XMLFormatter is implemented by types that define an XML format for XMLFormatValue.
+type XMLFormatter interface {
+	XMLFormat() XMLFormat
+}
+
+// XMLFormatValue is a monetary value that is marshalled to and unmarshalled from XML in the format defined by F.
+// This allows to use different XML representations for different struct fields:
+//
+//	type uniqueCodeFormat struct{}
+//
+//	func (uniqueCodeFormat) XMLFormat() money.XMLFormat {
+//		return money.XMLFormat{CurrencyAttr: "currency", Currency: money.XMLCurrencyUniqueCode}
+//	}
+//
+//	type Invoice struct {
+//		Total money.XMLFormatValue[uniqueCodeFormat] `xml:"total"` // Marshals into <total currency="ISO4217-EUR">12.34</total>.
+//	}
+type XMLFormatValue[F XMLFormatter] struct {
+	V Value
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (x XMLFormatValue[F]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	var f F
+	return f.XMLFormat().EncodeElement(e, start, x.V)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (x *XMLFormatValue[F]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var f F
+	v, err := f.XMLFormat().DecodeElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	x.V = v
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (x XMLFormatValue[F]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	var f F
+	return f.XMLFormat().MarshalAttr(name, x.V)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (x *XMLFormatValue[F]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var f F
+	v, err := f.XMLFormat().UnmarshalAttr(attr)
+	if err != nil {
+		return err
+	}
+
+	x.V = v
+	return nil
+}
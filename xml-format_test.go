@@ -0,0 +1,223 @@
+// Copyright (c) 2026 David Vogel
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package money
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// xmlFormatElement is an <Amt> element that is marshalled in the given format.
+type xmlFormatElement struct {
+	format XMLFormat
+	value  Value
+}
+
+func (x xmlFormatElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return x.format.EncodeElement(e, xml.StartElement{Name: xml.Name{Local: "Amt"}}, x.value)
+}
+
+func (x *xmlFormatElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
+	x.value, err = x.format.DecodeElement(d, start)
+	return err
+}
+
+func TestXMLFormat(t *testing.T) {
+	eur := MustFromString("12.34 ISO4217-EUR")
+
+	tests := []struct {
+		name    string
+		format  XMLFormat
+		value   Value
+		want    string
+		wantErr bool
+	}{
+		{"comment_1", XMLFormat{}, eur, `<Amt Ccy="EUR">12.34</Amt>`, false},
+		{"comment_2", XMLFormat{CurrencyAttr: "currency", Currency: XMLCurrencyUniqueCode}, eur, `<Amt currency="ISO4217-EUR">12.34</Amt>`, false},
+		{"1", XMLFormat{}, MustFromString("-12.34"), `<Amt>-12.34</Amt>`, false},
+		{"2", XMLFormat{}, MustFromString("1234 ISO4217-JPY"), `<Amt Ccy="JPY">1234</Amt>`, false},
+		{"3", XMLFormat{}, FromDecimal(decimal.RequireFromString("1"), testCurrency1), ``, true},
+		{"4", XMLFormat{Currency: XMLCurrencyUniqueCode}, MustFromString("-12.34"), `<Amt>-12.34</Amt>`, false},
+		{"5", XMLFormat{Currency: XMLCurrencyUniqueCode, Currencies: MustNewCurrencyCollection("Test", "", []Currency{testCurrency1})}, FromDecimal(decimal.RequireFromString("1"), testCurrency1), `<Amt Ccy="FOO-BAR">1</Amt>`, false},
+		{"6", XMLFormat{Currencies: MustNewCurrencyCollection("Test", "FOO", []Currency{testCurrency1})}, FromDecimal(decimal.RequireFromString("1"), testCurrency1), `<Amt Ccy="BAR">1</Amt>`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xml.Marshal(xmlFormatElement{format: tt.format, value: tt.value})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("XMLFormat.EncodeElement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("XMLFormat.EncodeElement() = %s, want %s", got, tt.want)
+			}
+
+			// Check roundtrip.
+			res := xmlFormatElement{format: tt.format}
+			if err := xml.Unmarshal(got, &res); err != nil {
+				t.Fatalf("XMLFormat.DecodeElement(%s) failed: %v", got, err)
+			}
+			if equal, err := res.value.EqualDetailed(tt.value); err != nil || !equal {
+				t.Errorf("XMLFormat.DecodeElement(%s) = %v, want %v", got, res.value, tt.value)
+			}
+		})
+	}
+}
+
+func TestXMLFormat_DecodeElement(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  XMLFormat
+		data    string
+		want    Value
+		wantErr bool
+	}{
+		{"1", XMLFormat{}, `<Amt Ccy="EUR">123.45</Amt>`, MustFromString("123.45 ISO4217-EUR"), false},
+		{"2", XMLFormat{}, "<Amt Ccy=\" EUR \">\n\t123.45\n</Amt>", MustFromString("123.45 ISO4217-EUR"), false},
+		{"3", XMLFormat{}, `<Amt xmlns:x="urn:x" x:Ccy="EUR">123.45</Amt>`, MustFromString("123.45 ISO4217-EUR"), false},
+		{"4", XMLFormat{}, `<Amt Ccy="EUR" Other="USD">123.45</Amt>`, MustFromString("123.45 ISO4217-EUR"), false},
+		{"5", XMLFormat{}, `<Amt>123.45</Amt>`, MustFromString("123.45"), false},
+		{"6", XMLFormat{CurrencyAttr: "currency"}, `<Amt Ccy="EUR" currency="USD">123.45</Amt>`, MustFromString("123.45 ISO4217-USD"), false},
+		{"7", XMLFormat{}, `<Amt Ccy="XYZ">123.45</Amt>`, Value{}, true},
+		{"8", XMLFormat{}, `<Amt Ccy="ISO4217-EUR">123.45</Amt>`, Value{}, true},
+		{"9", XMLFormat{Currency: XMLCurrencyUniqueCode}, `<Amt Ccy="EUR">123.45</Amt>`, Value{}, true},
+		{"10", XMLFormat{}, `<Amt Ccy="EUR"></Amt>`, Value{}, true},
+		{"11", XMLFormat{}, `<Amt Ccy="EUR">abc</Amt>`, Value{}, true},
+		{"12", XMLFormat{}, `<Amt Ccy="EUR">123.45`, Value{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := xmlFormatElement{format: tt.format}
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("XMLFormat.DecodeElement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.value.Equal(tt.want) {
+				t.Errorf("XMLFormat.DecodeElement() = %v, want %v", got.value, tt.want)
+			}
+		})
+	}
+
+	var errCode *ErrorCantFindCode
+	if err := xml.Unmarshal([]byte(`<Amt Ccy="XYZ">1</Amt>`), &xmlFormatElement{}); !errors.As(err, &errCode) {
+		t.Errorf("XMLFormat.DecodeElement() error = %v, want ErrorCantFindCode", err)
+	}
+}
+
+func TestValue_MarshalXML(t *testing.T) {
+	type payment struct {
+		XMLName xml.Name `xml:"CdtTrfTxInf"`
+		Amt     Value    `xml:"Amt>InstdAmt"`
+		Fee     Value    `xml:"Fee,attr"`
+		Limit   *Value   `xml:"Limit,omitempty"`
+	}
+
+	p := payment{
+		Amt: MustFromString("123.45 ISO4217-EUR"),
+		Fee: MustFromString("0.5 ISO4217-EUR"),
+	}
+	want := `<CdtTrfTxInf Fee="0.5 EUR"><Amt><InstdAmt Ccy="EUR">123.45</InstdAmt></Amt></CdtTrfTxInf>`
+
+	got, err := xml.Marshal(p)
+	if err != nil {
+		t.Fatalf("xml.Marshal() failed: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("xml.Marshal() = %s, want %s", got, want)
+	}
+
+	var res payment
+	if err := xml.Unmarshal(got, &res); err != nil {
+		t.Fatalf("xml.Unmarshal(%s) failed: %v", got, err)
+	}
+	if !res.Amt.Equal(p.Amt) || !res.Fee.Equal(p.Fee) || res.Limit != nil {
+		t.Errorf("xml.Unmarshal(%s) = %+v, want %+v", got, res, p)
+	}
+
+	// Invalid attributes.
+	if err := xml.Unmarshal([]byte(`<CdtTrfTxInf Fee="0.5 EUR 1"></CdtTrfTxInf>`), &res); err == nil {
+		t.Errorf("xml.Unmarshal() with invalid attribute didn't fail")
+	}
+	if err := xml.Unmarshal([]byte(`<CdtTrfTxInf Fee="0.5 ISO4217-XYZ"></CdtTrfTxInf>`), &res); err == nil {
+		t.Errorf("xml.Unmarshal() with unknown unique code attribute didn't fail")
+	}
+}
+
+func TestValue_UnmarshalXML_Legacy(t *testing.T) {
+	// Before Value implemented the XML interfaces, encoding/xml used its text representation.
+	type legacy struct {
+		XMLName xml.Name `xml:"T"`
+		A       Value    `xml:"a"`
+		B       Value    `xml:"b,attr"`
+		C       Value    `xml:"c"`
+	}
+
+	var res legacy
+	data := `<T b="1.5 ISO4217-EUR"><a>12.34 ISO4217-EUR</a><c>` + "\n\t-1\n" + `</c></T>`
+	if err := xml.Unmarshal([]byte(data), &res); err != nil {
+		t.Fatalf("xml.Unmarshal(%s) failed: %v", data, err)
+	}
+	if want := MustFromString("12.34 ISO4217-EUR"); !res.A.Equal(want) {
+		t.Errorf("xml.Unmarshal(%s) A = %v, want %v", data, res.A, want)
+	}
+	if want := MustFromString("1.5 ISO4217-EUR"); !res.B.Equal(want) {
+		t.Errorf("xml.Unmarshal(%s) B = %v, want %v", data, res.B, want)
+	}
+	if want := MustFromString("-1"); !res.C.Equal(want) {
+		t.Errorf("xml.Unmarshal(%s) C = %v, want %v", data, res.C, want)
+	}
+
+	// Mixing both representations is not allowed.
+	if err := xml.Unmarshal([]byte(`<T><a Ccy="EUR">12.34 ISO4217-EUR</a></T>`), &res); err == nil {
+		t.Errorf("xml.Unmarshal() with currency attribute and legacy text didn't fail")
+	}
+	if err := xml.Unmarshal([]byte(`<T><a>12.34 ISO4217-XYZ</a></T>`), &res); err == nil {
+		t.Errorf("xml.Unmarshal() with unknown legacy currency didn't fail")
+	}
+}
+
+type testXMLUniqueCodeFormat struct{}
+
+func (testXMLUniqueCodeFormat) XMLFormat() XMLFormat {
+	return XMLFormat{CurrencyAttr: "currency", Currency: XMLCurrencyUniqueCode}
+}
+
+func TestXMLFormatValue(t *testing.T) {
+	type invoice struct {
+		XMLName xml.Name                                `xml:"invoice"`
+		Total   XMLFormatValue[testXMLUniqueCodeFormat] `xml:"total"`
+		Tax     XMLFormatValue[testXMLUniqueCodeFormat] `xml:"tax,attr"`
+	}
+
+	i := invoice{
+		Total: XMLFormatValue[testXMLUniqueCodeFormat]{MustFromString("12.34 ISO4217-EUR")},
+		Tax:   XMLFormatValue[testXMLUniqueCodeFormat]{MustFromString("1.97 ISO4217-EUR")},
+	}
+	want := `<invoice tax="1.97 ISO4217-EUR"><total currency="ISO4217-EUR">12.34</total></invoice>`
+
+	got, err := xml.Marshal(i)
+	if err != nil {
+		t.Fatalf("xml.Marshal() failed: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("xml.Marshal() = %s, want %s", got, want)
+	}
+
+	var res invoice
+	if err := xml.Unmarshal(got, &res); err != nil {
+		t.Fatalf("xml.Unmarshal(%s) failed: %v", got, err)
+	}
+	if !res.Total.V.Equal(i.Total.V) || !res.Tax.V.Equal(i.Tax.V) {
+		t.Errorf("xml.Unmarshal(%s) = %+v, want %+v", got, res, i)
+	}
+}